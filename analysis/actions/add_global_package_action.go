@@ -0,0 +1,33 @@
+package actions
+
+import "fmt"
+
+type AddGlobalPackageAction struct {
+	packageName string
+	version     string
+	reason      string
+}
+
+func NewAddGlobalPackageAction(packageName string, version string, reason string) *AddGlobalPackageAction {
+	return &AddGlobalPackageAction{
+		packageName: packageName,
+		version:     version,
+		reason:      reason,
+	}
+}
+
+func (action *AddGlobalPackageAction) GetReason() string {
+	return action.reason
+}
+
+func (action *AddGlobalPackageAction) GetDescription() string {
+	return fmt.Sprintf(`Add global package "%s" (%s)`, action.packageName, action.version)
+}
+
+func (action *AddGlobalPackageAction) IsRecommended() bool {
+	return true
+}
+
+func (action *AddGlobalPackageAction) Execute(projectHandler ProjectHandler) error {
+	return projectHandler.AddGlobalPackage(action.packageName, action.version)
+}
@@ -0,0 +1,33 @@
+package actions
+
+import "fmt"
+
+type AddProjectToSolutionAction struct {
+	projectName   string
+	workspaceName string
+	reason        string
+}
+
+func NewAddProjectToSolutionAction(projectName string, workspaceName string, reason string) *AddProjectToSolutionAction {
+	return &AddProjectToSolutionAction{
+		projectName:   projectName,
+		workspaceName: workspaceName,
+		reason:        reason,
+	}
+}
+
+func (action *AddProjectToSolutionAction) GetReason() string {
+	return action.reason
+}
+
+func (action *AddProjectToSolutionAction) GetDescription() string {
+	return fmt.Sprintf(`Add project "%s" to "%s"`, action.projectName, action.workspaceName)
+}
+
+func (action *AddProjectToSolutionAction) IsRecommended() bool {
+	return false
+}
+
+func (action *AddProjectToSolutionAction) Execute(projectHandler ProjectHandler) error {
+	return projectHandler.AddProjectToSolution(action.projectName)
+}
@@ -0,0 +1,63 @@
+package actions
+
+import (
+	"fmt"
+	"strings"
+)
+
+type AlignVersionsAction struct {
+	packageName   string
+	targetVersion string
+	projectNames  []string
+	dependencies  []*PackageInfo //Dependency (with its current version) of each project
+}
+
+func NewAlignVersionsAction(packageName string, targetVersion string, projectNames []string, dependencies []*PackageInfo) *AlignVersionsAction {
+	return &AlignVersionsAction{
+		packageName:   packageName,
+		targetVersion: targetVersion,
+		projectNames:  projectNames,
+		dependencies:  dependencies,
+	}
+}
+
+func (action *AlignVersionsAction) GetReason() string {
+	projectVersions := make([]string, len(action.projectNames))
+	for index, projectName := range action.projectNames {
+		projectVersions[index] = fmt.Sprintf("%s (%s)", projectName, action.dependencies[index].Version)
+	}
+	return fmt.Sprintf(`Package "%s" is referenced with different versions: %s`, action.packageName, strings.Join(projectVersions, ", "))
+}
+
+func (action *AlignVersionsAction) GetDescription() string {
+	return fmt.Sprintf(`Align package "%s" to version "%s" in %d project(s)`, action.packageName, action.targetVersion, action.countOutdatedProjects())
+}
+
+func (action *AlignVersionsAction) countOutdatedProjects() int {
+	count := 0
+	for _, dependency := range action.dependencies {
+		if dependency.Version != action.targetVersion {
+			count++
+		}
+	}
+	return count
+}
+
+func (action *AlignVersionsAction) IsRecommended() bool {
+	return false
+}
+
+func (action *AlignVersionsAction) Execute(projectHandler ProjectHandler) error {
+	for index, projectName := range action.projectNames {
+		dependency := action.dependencies[index]
+		if dependency.Version == action.targetVersion {
+			continue
+		}
+
+		updated := projectHandler.UpdateDependencyVersion(projectName, dependency, action.targetVersion)
+		if !updated {
+			return fmt.Errorf(`failed to update package "%s" to version "%s" in "%s"`, action.packageName, action.targetVersion, projectName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package actions
+
+import "fmt"
+
+type ConvertToPackageReferenceAction struct {
+	projectName       string
+	referencedProject *PackageInfo
+	packageInfo       *PackageInfo
+	reason            string
+}
+
+func NewConvertToPackageReferenceAction(projectName string, referencedProject *PackageInfo, packageInfo *PackageInfo, reason string) *ConvertToPackageReferenceAction {
+	return &ConvertToPackageReferenceAction{
+		projectName:       projectName,
+		referencedProject: referencedProject,
+		packageInfo:       packageInfo,
+		reason:            reason,
+	}
+}
+
+func (action *ConvertToPackageReferenceAction) GetReason() string {
+	return action.reason
+}
+
+func (action *ConvertToPackageReferenceAction) GetDescription() string {
+	description := fmt.Sprintf(`Replace the reference to project "%s" with package "%s" (%s) in "%s"`, action.referencedProject.Name, action.packageInfo.Name, action.packageInfo.Version, action.projectName)
+	return description
+}
+
+func (action *ConvertToPackageReferenceAction) IsRecommended() bool {
+	return false
+}
+
+func (action *ConvertToPackageReferenceAction) Execute(projectHandler ProjectHandler) error {
+	projectHandler.RemoveDependency(action.projectName, action.referencedProject)
+	projectHandler.AddDependency(action.projectName, action.packageInfo)
+	return nil
+}
@@ -0,0 +1,38 @@
+package actions
+
+import "fmt"
+
+type ConvertToProjectReferenceAction struct {
+	projectName       string
+	packageInfo       *PackageInfo
+	referencedProject *PackageInfo
+	reason            string
+}
+
+func NewConvertToProjectReferenceAction(projectName string, packageInfo *PackageInfo, referencedProject *PackageInfo, reason string) *ConvertToProjectReferenceAction {
+	return &ConvertToProjectReferenceAction{
+		projectName:       projectName,
+		packageInfo:       packageInfo,
+		referencedProject: referencedProject,
+		reason:            reason,
+	}
+}
+
+func (action *ConvertToProjectReferenceAction) GetReason() string {
+	return action.reason
+}
+
+func (action *ConvertToProjectReferenceAction) GetDescription() string {
+	description := fmt.Sprintf(`Replace package "%s" with a reference to project "%s" in "%s"`, action.packageInfo.Name, action.referencedProject.Name, action.projectName)
+	return description
+}
+
+func (action *ConvertToProjectReferenceAction) IsRecommended() bool {
+	return false
+}
+
+func (action *ConvertToProjectReferenceAction) Execute(projectHandler ProjectHandler) error {
+	projectHandler.RemoveDependency(action.projectName, action.packageInfo)
+	projectHandler.AddDependency(action.projectName, action.referencedProject)
+	return nil
+}
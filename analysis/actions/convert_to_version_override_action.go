@@ -0,0 +1,38 @@
+package actions
+
+import "fmt"
+
+type ConvertToVersionOverrideAction struct {
+	projectName  string
+	packageName  string
+	localVersion string
+	recommended  bool
+	reason       string
+}
+
+func NewConvertToVersionOverrideAction(projectName string, packageName string, localVersion string, recommended bool, reason string) *ConvertToVersionOverrideAction {
+	return &ConvertToVersionOverrideAction{
+		projectName:  projectName,
+		packageName:  packageName,
+		localVersion: localVersion,
+		recommended:  recommended,
+		reason:       reason,
+	}
+}
+
+func (action *ConvertToVersionOverrideAction) GetReason() string {
+	return action.reason
+}
+
+func (action *ConvertToVersionOverrideAction) GetDescription() string {
+	description := fmt.Sprintf(`Convert the local version "%s" of package "%s" into a "VersionOverride" in "%s"`, action.localVersion, action.packageName, action.projectName)
+	return description
+}
+
+func (action *ConvertToVersionOverrideAction) IsRecommended() bool {
+	return action.recommended
+}
+
+func (action *ConvertToVersionOverrideAction) Execute(projectHandler ProjectHandler) error {
+	return projectHandler.ConvertToVersionOverride(action.projectName, action.packageName)
+}
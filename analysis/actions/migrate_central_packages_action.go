@@ -0,0 +1,38 @@
+package actions
+
+import "fmt"
+
+type MigrateCentralPackagesAction struct {
+	packageVersions map[string]string //PackageName => Version
+	conflictCount   int
+	versionPolicy   string
+}
+
+func NewMigrateCentralPackagesAction(packageVersions map[string]string, conflictCount int, versionPolicy string) *MigrateCentralPackagesAction {
+	return &MigrateCentralPackagesAction{
+		packageVersions: packageVersions,
+		conflictCount:   conflictCount,
+		versionPolicy:   versionPolicy,
+	}
+}
+
+func (action *MigrateCentralPackagesAction) GetReason() string {
+	if action.conflictCount == 0 {
+		return fmt.Sprintf("Every project declares its own package versions (%d packages)", len(action.packageVersions))
+	}
+	return fmt.Sprintf(`Every project declares its own package versions (%d packages, %d with different versions resolved using the "%s" policy)`,
+		len(action.packageVersions), action.conflictCount, action.versionPolicy,
+	)
+}
+
+func (action *MigrateCentralPackagesAction) GetDescription() string {
+	return "Migrate to Central Package Management"
+}
+
+func (action *MigrateCentralPackagesAction) IsRecommended() bool {
+	return false
+}
+
+func (action *MigrateCentralPackagesAction) Execute(projectHandler ProjectHandler) error {
+	return projectHandler.CentralizePackageVersions(action.packageVersions)
+}
@@ -0,0 +1,35 @@
+package actions
+
+import "fmt"
+
+type NormalizeProjectReferenceAction struct {
+	projectName    string
+	referenceName  string
+	normalizedPath string
+	reason         string
+}
+
+func NewNormalizeProjectReferenceAction(projectName string, referenceName string, normalizedPath string, reason string) *NormalizeProjectReferenceAction {
+	return &NormalizeProjectReferenceAction{
+		projectName:    projectName,
+		referenceName:  referenceName,
+		normalizedPath: normalizedPath,
+		reason:         reason,
+	}
+}
+
+func (action *NormalizeProjectReferenceAction) GetReason() string {
+	return action.reason
+}
+
+func (action *NormalizeProjectReferenceAction) GetDescription() string {
+	return fmt.Sprintf(`Change the path of project reference "%s" to "%s" in "%s"`, action.referenceName, action.normalizedPath, action.projectName)
+}
+
+func (action *NormalizeProjectReferenceAction) IsRecommended() bool {
+	return true
+}
+
+func (action *NormalizeProjectReferenceAction) Execute(projectHandler ProjectHandler) error {
+	return projectHandler.UpdateProjectReferencePath(action.projectName, action.referenceName, action.normalizedPath)
+}
@@ -0,0 +1,51 @@
+package actions
+
+import (
+	"fmt"
+	"redun-pendancy/utils"
+)
+
+type PinPackageVersionAction struct {
+	projectName     string
+	packageInfo     *PackageInfo
+	declaredVersion string
+	isGlobalPackage bool
+	reason          string
+}
+
+func NewPinPackageVersionAction(projectName string, packageInfo *PackageInfo, declaredVersion string, isGlobalPackage bool, reason string) *PinPackageVersionAction {
+	return &PinPackageVersionAction{
+		projectName:     projectName,
+		packageInfo:     packageInfo,
+		declaredVersion: declaredVersion,
+		isGlobalPackage: isGlobalPackage,
+		reason:          reason,
+	}
+}
+
+func (action *PinPackageVersionAction) GetReason() string {
+	return action.reason
+}
+
+func (action *PinPackageVersionAction) GetDescription() string {
+	versionChange := utils.TernarySelect(action.declaredVersion == "",
+		fmt.Sprintf(`to "%s"`, action.packageInfo.Version),
+		fmt.Sprintf(`from "%s" to "%s"`, action.declaredVersion, action.packageInfo.Version),
+	)
+	if action.isGlobalPackage {
+		return fmt.Sprintf(`Pin global package "%s" %s`, action.packageInfo.Name, versionChange)
+	}
+	return fmt.Sprintf(`Pin package "%s" %s in "%s"`, action.packageInfo.Name, versionChange, action.projectName)
+}
+
+func (action *PinPackageVersionAction) IsRecommended() bool {
+	return true
+}
+
+func (action *PinPackageVersionAction) Execute(projectHandler ProjectHandler) error {
+	updated := projectHandler.UpdateDependencyVersion(action.projectName, action.packageInfo, action.packageInfo.Version)
+	if !updated {
+		return fmt.Errorf(`failed to pin package "%s" to version "%s"`, action.packageInfo.Name, action.packageInfo.Version)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package actions
+
+import "fmt"
+
+type RemoveProjectAction struct {
+	projectName   string
+	workspaceName string
+	reason        string
+}
+
+func NewRemoveProjectAction(projectName string, workspaceName string, reason string) *RemoveProjectAction {
+	return &RemoveProjectAction{
+		projectName:   projectName,
+		workspaceName: workspaceName,
+		reason:        reason,
+	}
+}
+
+func (action *RemoveProjectAction) GetReason() string {
+	return action.reason
+}
+
+func (action *RemoveProjectAction) GetDescription() string {
+	return fmt.Sprintf(`Remove project "%s" from "%s"`, action.projectName, action.workspaceName)
+}
+
+func (action *RemoveProjectAction) IsRecommended() bool {
+	return false
+}
+
+func (action *RemoveProjectAction) Execute(projectHandler ProjectHandler) error {
+	return projectHandler.RemoveProject(action.projectName)
+}
@@ -0,0 +1,36 @@
+package actions
+
+import "fmt"
+
+type RemoveVersionOverrideAction struct {
+	projectName     string
+	packageName     string
+	versionOverride string
+	reason          string
+}
+
+func NewRemoveVersionOverrideAction(projectName string, packageName string, versionOverride string, reason string) *RemoveVersionOverrideAction {
+	return &RemoveVersionOverrideAction{
+		projectName:     projectName,
+		packageName:     packageName,
+		versionOverride: versionOverride,
+		reason:          reason,
+	}
+}
+
+func (action *RemoveVersionOverrideAction) GetReason() string {
+	return action.reason
+}
+
+func (action *RemoveVersionOverrideAction) GetDescription() string {
+	description := fmt.Sprintf(`Remove the "VersionOverride" "%s" of package "%s" from "%s"`, action.versionOverride, action.packageName, action.projectName)
+	return description
+}
+
+func (action *RemoveVersionOverrideAction) IsRecommended() bool {
+	return true
+}
+
+func (action *RemoveVersionOverrideAction) Execute(projectHandler ProjectHandler) error {
+	return projectHandler.RemoveVersionOverride(action.projectName, action.packageName)
+}
@@ -0,0 +1,38 @@
+package actions
+
+import "fmt"
+
+type ReplacePackageAction struct {
+	projectName     string
+	packageInfo     *PackageInfo
+	replacementName string
+	targetVersion   string
+	reason          string
+}
+
+func NewReplacePackageAction(projectName string, packageInfo *PackageInfo, replacementName string, targetVersion string, reason string) *ReplacePackageAction {
+	return &ReplacePackageAction{
+		projectName:     projectName,
+		packageInfo:     packageInfo,
+		replacementName: replacementName,
+		targetVersion:   targetVersion,
+		reason:          reason,
+	}
+}
+
+func (action *ReplacePackageAction) GetReason() string {
+	return action.reason
+}
+
+func (action *ReplacePackageAction) GetDescription() string {
+	description := fmt.Sprintf(`Replace package "%s" with "%s" (%s) in "%s"`, action.packageInfo.Name, action.replacementName, action.targetVersion, action.projectName)
+	return description
+}
+
+func (action *ReplacePackageAction) IsRecommended() bool {
+	return false
+}
+
+func (action *ReplacePackageAction) Execute(projectHandler ProjectHandler) error {
+	return projectHandler.ReplaceDependency(action.projectName, action.packageInfo, action.replacementName, action.targetVersion)
+}
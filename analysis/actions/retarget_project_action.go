@@ -0,0 +1,36 @@
+package actions
+
+import "fmt"
+
+type RetargetProjectAction struct {
+	projectName  string
+	oldFramework string
+	newFramework string
+	reason       string
+}
+
+func NewRetargetProjectAction(projectName string, oldFramework string, newFramework string, reason string) *RetargetProjectAction {
+	return &RetargetProjectAction{
+		projectName:  projectName,
+		oldFramework: oldFramework,
+		newFramework: newFramework,
+		reason:       reason,
+	}
+}
+
+func (action *RetargetProjectAction) GetReason() string {
+	return action.reason
+}
+
+func (action *RetargetProjectAction) GetDescription() string {
+	description := fmt.Sprintf(`Retarget "%s" from "%s" to "%s"`, action.projectName, action.oldFramework, action.newFramework)
+	return description
+}
+
+func (action *RetargetProjectAction) IsRecommended() bool {
+	return false
+}
+
+func (action *RetargetProjectAction) Execute(projectHandler ProjectHandler) error {
+	return projectHandler.RetargetProject(action.projectName, action.newFramework)
+}
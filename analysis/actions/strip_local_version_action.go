@@ -0,0 +1,38 @@
+package actions
+
+import "fmt"
+
+type StripLocalVersionAction struct {
+	projectName  string
+	packageName  string
+	localVersion string
+	recommended  bool
+	reason       string
+}
+
+func NewStripLocalVersionAction(projectName string, packageName string, localVersion string, recommended bool, reason string) *StripLocalVersionAction {
+	return &StripLocalVersionAction{
+		projectName:  projectName,
+		packageName:  packageName,
+		localVersion: localVersion,
+		recommended:  recommended,
+		reason:       reason,
+	}
+}
+
+func (action *StripLocalVersionAction) GetReason() string {
+	return action.reason
+}
+
+func (action *StripLocalVersionAction) GetDescription() string {
+	description := fmt.Sprintf(`Remove the local version "%s" of package "%s" from "%s"`, action.localVersion, action.packageName, action.projectName)
+	return description
+}
+
+func (action *StripLocalVersionAction) IsRecommended() bool {
+	return action.recommended
+}
+
+func (action *StripLocalVersionAction) Execute(projectHandler ProjectHandler) error {
+	return projectHandler.StripLocalVersion(action.projectName, action.packageName)
+}
@@ -0,0 +1,42 @@
+package actions
+
+import "fmt"
+
+type UpdatePackageVersionAction struct {
+	projectName   string
+	packageInfo   *PackageInfo
+	targetVersion string
+	recommended   bool
+	reason        string
+}
+
+func NewUpdatePackageVersionAction(projectName string, packageInfo *PackageInfo, targetVersion string, recommended bool, reason string) *UpdatePackageVersionAction {
+	return &UpdatePackageVersionAction{
+		projectName:   projectName,
+		packageInfo:   packageInfo,
+		targetVersion: targetVersion,
+		recommended:   recommended,
+		reason:        reason,
+	}
+}
+
+func (action *UpdatePackageVersionAction) GetReason() string {
+	return action.reason
+}
+
+func (action *UpdatePackageVersionAction) GetDescription() string {
+	description := fmt.Sprintf(`Update package "%s" from "%s" to "%s" in "%s"`, action.packageInfo.Name, action.packageInfo.Version, action.targetVersion, action.projectName)
+	return description
+}
+
+func (action *UpdatePackageVersionAction) IsRecommended() bool {
+	return action.recommended
+}
+
+func (action *UpdatePackageVersionAction) Execute(projectHandler ProjectHandler) error {
+	updated := projectHandler.UpdateDependencyVersion(action.projectName, action.packageInfo, action.targetVersion)
+	if !updated {
+		return fmt.Errorf(`failed to update package "%s" to version "%s" in "%s"`, action.packageInfo.Name, action.targetVersion, action.projectName)
+	}
+	return nil
+}
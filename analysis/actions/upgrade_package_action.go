@@ -0,0 +1,46 @@
+package actions
+
+import (
+	"fmt"
+	"strings"
+)
+
+type UpgradePackageAction struct {
+	projectName     string
+	dependency      *PackageInfo
+	targetVersion   string
+	skippedPackages []*PackageInfo //Older package versions no longer pulled in after the upgrade
+}
+
+func NewUpgradePackageAction(projectName string, dependency *PackageInfo, targetVersion string, skippedPackages []*PackageInfo) *UpgradePackageAction {
+	return &UpgradePackageAction{
+		projectName:     projectName,
+		dependency:      dependency,
+		targetVersion:   targetVersion,
+		skippedPackages: skippedPackages,
+	}
+}
+
+func (action *UpgradePackageAction) GetReason() string {
+	skippedPackages := make([]string, len(action.skippedPackages))
+	for index, skippedPackage := range action.skippedPackages {
+		skippedPackages[index] = skippedPackage.ToString()
+	}
+	return fmt.Sprintf(`Package "%s" pulls in older package versions: %s`, action.dependency.ToString(), strings.Join(skippedPackages, ", "))
+}
+
+func (action *UpgradePackageAction) GetDescription() string {
+	return fmt.Sprintf(`Upgrade package "%s" to "%s" in "%s"`, action.dependency.Name, action.targetVersion, action.projectName)
+}
+
+func (action *UpgradePackageAction) IsRecommended() bool {
+	return false
+}
+
+func (action *UpgradePackageAction) Execute(projectHandler ProjectHandler) error {
+	updated := projectHandler.UpdateDependencyVersion(action.projectName, action.dependency, action.targetVersion)
+	if !updated {
+		return fmt.Errorf(`failed to upgrade package "%s" to version "%s" in "%s"`, action.dependency.Name, action.targetVersion, action.projectName)
+	}
+	return nil
+}
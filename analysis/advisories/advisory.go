@@ -0,0 +1,87 @@
+package advisories
+
+import (
+	"redun-pendancy/utils"
+	"strings"
+)
+
+// Advisory is a known vulnerability affecting some versions of a package
+type Advisory struct {
+	Id          string
+	Summary     string
+	Severity    string //eg: "LOW", "MODERATE", "HIGH", "CRITICAL" (or "UNKNOWN")
+	PackageName string
+	Ranges      []AffectedRange
+	Versions    []string //Explicitly affected versions (on top of the ranges)
+}
+
+// AffectedRange is a range of affected versions. Empty bounds mean "unbounded"
+type AffectedRange struct {
+	Introduced   string //Inclusive
+	Fixed        string //Exclusive
+	LastAffected string //Inclusive
+}
+
+func (advisory *Advisory) Affects(version string) bool {
+	for _, affectedVersion := range advisory.Versions {
+		result, isComparable := compareVersions(version, affectedVersion)
+		if (isComparable && result == 0) || strings.EqualFold(version, affectedVersion) {
+			return true
+		}
+	}
+	for _, affectedRange := range advisory.Ranges {
+		if affectedRange.Contains(version) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the lowest fixed version above the given version (or "" if no fix is known)
+func (advisory *Advisory) GetLowestFixedVersion(version string) string {
+	lowestFixedVersion := ""
+	for _, affectedRange := range advisory.Ranges {
+		fixedVersion := affectedRange.Fixed
+		if fixedVersion == "" {
+			continue
+		}
+		result, isComparable := compareVersions(fixedVersion, version)
+		if isComparable && result <= 0 {
+			continue
+		}
+		result, isComparable = compareVersions(fixedVersion, lowestFixedVersion)
+		if lowestFixedVersion == "" || (isComparable && result < 0) {
+			lowestFixedVersion = fixedVersion
+		}
+	}
+	return lowestFixedVersion
+}
+
+// NOTE: Versions that can't be compared against a bound are considered affected (a false alarm beats a hidden vulnerability)
+func (affectedRange *AffectedRange) Contains(version string) bool {
+	if affectedRange.Introduced != "" && affectedRange.Introduced != "0" {
+		result, isComparable := compareVersions(version, affectedRange.Introduced)
+		if isComparable && result < 0 {
+			return false
+		}
+	}
+	if affectedRange.Fixed != "" {
+		result, isComparable := compareVersions(version, affectedRange.Fixed)
+		if isComparable && result >= 0 {
+			return false
+		}
+	}
+	if affectedRange.LastAffected != "" {
+		result, isComparable := compareVersions(version, affectedRange.LastAffected)
+		if isComparable && result > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the comparison result and whether both versions could be parsed
+func compareVersions(left string, right string) (int, bool) {
+	result, err := utils.CompareVersions(left, right)
+	return result, err == nil
+}
@@ -0,0 +1,136 @@
+package advisories
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// AdvisoryDatabase holds the advisories read from a local folder (no network access involved).
+//
+// Supported formats (any ".json" file, searched recursively):
+//   - OSV: One advisory per file (or an array of them), as found in OSV dumps & the GitHub advisory database repository.
+//   - GitHub export: The "securityVulnerabilities" nodes of GitHub's GraphQL API (or an array of them).
+//
+// NOTE: Only "NuGet" advisories are kept. Package names are matched case-insensitively.
+type AdvisoryDatabase struct {
+	advisories map[string][]*Advisory //LowerPackageName => []Advisory
+}
+
+func LoadDatabase(folderPath string) (*AdvisoryDatabase, error) {
+	database := &AdvisoryDatabase{
+		advisories: make(map[string][]*Advisory),
+	}
+
+	err := filepath.WalkDir(folderPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !strings.EqualFold(filepath.Ext(filePath), ".json") {
+			return nil
+		}
+
+		advisories, err := readAdvisoryFile(filePath)
+		if err != nil {
+			//A single broken file should not discard the whole database
+			log.Printf(`[Warning] Skipped advisory file "%s": %v`, filePath, err)
+			return nil
+		}
+		for _, advisory := range advisories {
+			database.addAdvisory(advisory)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return database, nil
+}
+
+func (database *AdvisoryDatabase) addAdvisory(advisory *Advisory) {
+	key := strings.ToLower(advisory.PackageName)
+	database.advisories[key] = append(database.advisories[key], advisory)
+}
+
+// Returns the advisories affecting the package version
+func (database *AdvisoryDatabase) FindAdvisories(packageName string, version string) []*Advisory {
+	var matches []*Advisory
+	for _, advisory := range database.advisories[strings.ToLower(packageName)] {
+		if advisory.Affects(version) {
+			matches = append(matches, advisory)
+		}
+	}
+	return matches
+}
+
+func readAdvisoryFile(filePath string) ([]*Advisory, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []json.RawMessage
+	trimmedData := strings.TrimSpace(string(data))
+	if strings.HasPrefix(trimmedData, "[") {
+		err = json.Unmarshal(data, &entries)
+	} else {
+		entries, err = unwrapGitHubExport(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var advisories []*Advisory
+	for _, entry := range entries {
+		entryAdvisories, err := parseAdvisoryEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		advisories = append(advisories, entryAdvisories...)
+	}
+	return advisories, nil
+}
+
+// Returns the nodes of a GitHub GraphQL response (eg: "{ data: { securityVulnerabilities: { nodes: [...] } } }"), or the object itself
+func unwrapGitHubExport(data []byte) ([]json.RawMessage, error) {
+	var response struct {
+		Data struct {
+			SecurityVulnerabilities struct {
+				Nodes []json.RawMessage `json:"nodes"`
+			} `json:"securityVulnerabilities"`
+		} `json:"data"`
+	}
+	err := json.Unmarshal(data, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := response.Data.SecurityVulnerabilities.Nodes
+	if len(nodes) != 0 {
+		return nodes, nil
+	}
+	return []json.RawMessage{data}, nil
+}
+
+func parseAdvisoryEntry(entry json.RawMessage) ([]*Advisory, error) {
+	var probe struct {
+		Affected               json.RawMessage `json:"affected"`
+		VulnerableVersionRange *string         `json:"vulnerableVersionRange"`
+	}
+	err := json.Unmarshal(entry, &probe)
+	if err != nil {
+		return nil, err
+	}
+
+	if probe.Affected != nil {
+		return parseOsvAdvisory(entry)
+	}
+	if probe.VulnerableVersionRange != nil {
+		return parseGitHubVulnerability(entry)
+	}
+	return nil, fmt.Errorf("unknown advisory format")
+}
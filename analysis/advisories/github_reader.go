@@ -0,0 +1,79 @@
+package advisories
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type gitHubVulnerability struct {
+	Severity               string `json:"severity"`
+	VulnerableVersionRange string `json:"vulnerableVersionRange"`
+	FirstPatchedVersion    *struct {
+		Identifier string `json:"identifier"`
+	} `json:"firstPatchedVersion"`
+	Package struct {
+		Ecosystem string `json:"ecosystem"`
+		Name      string `json:"name"`
+	} `json:"package"`
+	Advisory struct {
+		GhsaId  string `json:"ghsaId"`
+		Summary string `json:"summary"`
+	} `json:"advisory"`
+}
+
+func parseGitHubVulnerability(data json.RawMessage) ([]*Advisory, error) {
+	var vulnerability gitHubVulnerability
+	err := json.Unmarshal(data, &vulnerability)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(vulnerability.Package.Ecosystem, "NuGet") {
+		return nil, nil
+	}
+
+	affectedRange, err := parseGitHubVersionRange(vulnerability.VulnerableVersionRange)
+	if err != nil {
+		return nil, err
+	}
+	if vulnerability.FirstPatchedVersion != nil && affectedRange.Fixed == "" {
+		affectedRange.Fixed = vulnerability.FirstPatchedVersion.Identifier
+	}
+
+	advisory := &Advisory{
+		Id:          vulnerability.Advisory.GhsaId,
+		Summary:     vulnerability.Advisory.Summary,
+		Severity:    strings.ToUpper(vulnerability.Severity),
+		PackageName: vulnerability.Package.Name,
+		Ranges:      []AffectedRange{affectedRange},
+	}
+	return []*Advisory{advisory}, nil
+}
+
+// Parses GitHub's version ranges (eg: "< 13.0.1", ">= 1.0, < 2.0", "= 1.2.3", "<= 1.0")
+func parseGitHubVersionRange(versionRange string) (AffectedRange, error) {
+	affectedRange := AffectedRange{}
+	for _, condition := range strings.Split(versionRange, ",") {
+		condition = strings.TrimSpace(condition)
+		operator, version, found := strings.Cut(condition, " ")
+		if !found {
+			return affectedRange, fmt.Errorf("invalid version range: %s", versionRange)
+		}
+
+		version = strings.TrimSpace(version)
+		switch operator {
+		case ">=":
+			affectedRange.Introduced = version
+		case "<":
+			affectedRange.Fixed = version
+		case "<=":
+			affectedRange.LastAffected = version
+		case "=":
+			affectedRange.Introduced = version
+			affectedRange.LastAffected = version
+		default:
+			return affectedRange, fmt.Errorf("unsupported version range operator: %s", operator)
+		}
+	}
+	return affectedRange, nil
+}
@@ -0,0 +1,128 @@
+package advisories
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+type osvEntry struct {
+	Id               string          `json:"id"`
+	Summary          string          `json:"summary"`
+	Details          string          `json:"details"`
+	Affected         []osvAffected   `json:"affected"`
+	Severity         []osvSeverity   `json:"severity"`
+	DatabaseSpecific osvDatabaseInfo `json:"database_specific"`
+}
+
+type osvAffected struct {
+	Package struct {
+		Ecosystem string `json:"ecosystem"`
+		Name      string `json:"name"`
+	} `json:"package"`
+	Ranges           []osvRange      `json:"ranges"`
+	Versions         []string        `json:"versions"`
+	DatabaseSpecific osvDatabaseInfo `json:"database_specific"`
+}
+
+type osvRange struct {
+	Type   string     `json:"type"`
+	Events []osvEvent `json:"events"`
+}
+
+type osvEvent struct {
+	Introduced   string `json:"introduced"`
+	Fixed        string `json:"fixed"`
+	LastAffected string `json:"last_affected"`
+}
+
+type osvSeverity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+type osvDatabaseInfo struct {
+	Severity string `json:"severity"`
+}
+
+func parseOsvAdvisory(data json.RawMessage) ([]*Advisory, error) {
+	var entry osvEntry
+	err := json.Unmarshal(data, &entry)
+	if err != nil {
+		return nil, err
+	}
+
+	var advisories []*Advisory
+	for _, affected := range entry.Affected {
+		if !strings.EqualFold(affected.Package.Ecosystem, "NuGet") {
+			continue
+		}
+
+		advisories = append(advisories, &Advisory{
+			Id:          entry.Id,
+			Summary:     getOsvSummary(entry),
+			Severity:    getOsvSeverity(entry, affected),
+			PackageName: affected.Package.Name,
+			Ranges:      convertOsvRanges(affected.Ranges),
+			Versions:    affected.Versions,
+		})
+	}
+	return advisories, nil
+}
+
+func getOsvSummary(entry osvEntry) string {
+	if entry.Summary != "" {
+		return entry.Summary
+	}
+	//Keep only the first line of the details
+	summary, _, _ := strings.Cut(strings.TrimSpace(entry.Details), "\n")
+	return summary
+}
+
+func getOsvSeverity(entry osvEntry, affected osvAffected) string {
+	if affected.DatabaseSpecific.Severity != "" {
+		return strings.ToUpper(affected.DatabaseSpecific.Severity)
+	}
+	if entry.DatabaseSpecific.Severity != "" {
+		return strings.ToUpper(entry.DatabaseSpecific.Severity)
+	}
+	if len(entry.Severity) != 0 {
+		//eg: "CVSS_V3: CVSS:3.1/AV:N/AC:L/..."
+		return entry.Severity[0].Type + ": " + entry.Severity[0].Score
+	}
+	return "UNKNOWN"
+}
+
+// Converts the "introduced/fixed/last_affected" event sequences into ranges
+func convertOsvRanges(ranges []osvRange) []AffectedRange {
+	var affectedRanges []AffectedRange
+	for _, osvRange := range ranges {
+		if osvRange.Type == "GIT" {
+			//Commit hashes, nothing to compare versions against
+			continue
+		}
+
+		var currentRange *AffectedRange
+		for _, event := range osvRange.Events {
+			switch {
+			case event.Introduced != "":
+				if currentRange != nil {
+					affectedRanges = append(affectedRanges, *currentRange)
+				}
+				currentRange = &AffectedRange{Introduced: event.Introduced}
+
+			case event.Fixed != "" || event.LastAffected != "":
+				if currentRange == nil {
+					currentRange = &AffectedRange{}
+				}
+				currentRange.Fixed = event.Fixed
+				currentRange.LastAffected = event.LastAffected
+				affectedRanges = append(affectedRanges, *currentRange)
+				currentRange = nil
+			}
+		}
+		if currentRange != nil {
+			affectedRanges = append(affectedRanges, *currentRange)
+		}
+	}
+	return affectedRanges
+}
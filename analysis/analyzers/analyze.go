@@ -3,20 +3,41 @@ package analyzers
 import (
 	"fmt"
 	"redun-pendancy/analysis"
+	"redun-pendancy/config"
 )
 
 type Analyzer interface {
 	Analyze(projects []*PackageInfo, packages map[string]*PackageInfo)
 }
 
-func AnalyzeProject(projectHandler ProjectHandler) *AnalysisResults {
+func AnalyzeProject(projectHandler ProjectHandler, analysisConfig *config.Config) *AnalysisResults {
 	results := analysis.NewAnalysisResults()
 	analyzers := []Analyzer{
 		NewUnusedGlobalPackagesAnalyzer(results, projectHandler),
 		NewUnsortedDependenciesAnalyzer(results, projectHandler),
-		NewRedundancyAnalyzer(results),
-		NewBubbleUpAnalyzer(results),
-		NewUpgradeAnalyzer(results),
+		NewCircularDependencyAnalyzer(results, projectHandler),
+		NewOrphanedProjectAnalyzer(results, projectHandler),
+		NewProjectReferenceAnalyzer(results, projectHandler),
+		NewProjectPackageAnalyzer(results, projectHandler),
+		NewFrameworkCompatibilityAnalyzer(results, projectHandler),
+		NewFrameworkLifecycleAnalyzer(results, projectHandler),
+		NewTestLeakageAnalyzer(results, projectHandler),
+		NewSharedFrameworkAnalyzer(results, projectHandler),
+		NewRedundancyAnalyzer(results, projectHandler),
+		NewBubbleUpAnalyzer(results, projectHandler),
+		NewUpgradeAnalyzer(results, projectHandler),
+		NewPackageDowngradeAnalyzer(results, projectHandler),
+		NewVersionConflictAnalyzer(results, projectHandler),
+		NewVersionDriftAnalyzer(results, projectHandler),
+		NewFloatingVersionAnalyzer(results, projectHandler),
+		NewCentralPackageMigrationAnalyzer(results, projectHandler, analysisConfig.CentralPackages.VersionPolicy),
+		NewCentralPackageConsistencyAnalyzer(results, projectHandler),
+		NewVulnerabilityAnalyzer(results, projectHandler, analysisConfig.Advisories.Directory),
+		NewLicenseAnalyzer(results, projectHandler, analysisConfig.Licenses),
+		NewLayeringAnalyzer(results, projectHandler, analysisConfig.Layering),
+		NewBannedPackageAnalyzer(results, projectHandler, analysisConfig.BannedPackages),
+		NewPrereleaseAnalyzer(results, projectHandler, analysisConfig.Prerelease),
+		NewUnusedPackageAnalyzer(results, projectHandler, analysisConfig.UnusedPackages),
 	}
 
 	projects := projectHandler.GetProjects()
@@ -0,0 +1,112 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/config"
+	"redun-pendancy/utils"
+	"sort"
+	"strings"
+)
+
+// BannedPackageAnalyzer reports packages (direct or transitive) that must not be used, offering to replace the direct ones
+type BannedPackageAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+	bannedPackages []config.BannedPackage
+}
+
+func NewBannedPackageAnalyzer(results *AnalysisResults, projectHandler ProjectHandler, bannedPackages []config.BannedPackage) *BannedPackageAnalyzer {
+	return &BannedPackageAnalyzer{
+		results:        results,
+		projectHandler: projectHandler,
+		bannedPackages: bannedPackages,
+	}
+}
+
+func (analyzer *BannedPackageAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	if len(analyzer.bannedPackages) == 0 {
+		return
+	}
+
+	for _, project := range projects {
+		resolution := analyzer.projectHandler.GetResolution(project)
+		packageNames := utils.GetMapKeys(resolution.Resolved)
+		sort.Strings(packageNames)
+		for _, packageName := range packageNames {
+			resolvedPackage := resolution.Resolved[packageName]
+			if resolvedPackage.PackageInfo.IsProject() {
+				continue
+			}
+
+			bannedPackage, isBanned := analyzer.findBannedPackage(packageName)
+			if !isBanned {
+				continue
+			}
+
+			if resolvedPackage.IsDeclaredBy(project) {
+				analyzer.processDirectReference(project, packageName, bannedPackage)
+				continue
+			}
+			suggestion := fmt.Sprintf(`Package "%s" is banned%s. Brought in by: "%s/%s"`,
+				packageName, formatBanDetails(bannedPackage, packageName), project.Name, resolvedPackage.FormatPath(),
+			)
+			analyzer.results.AddSuggestion(project.Name, suggestion)
+		}
+	}
+}
+
+func (analyzer *BannedPackageAnalyzer) findBannedPackage(packageName string) (config.BannedPackage, bool) {
+	return utils.FirstOrDefault(analyzer.bannedPackages, func(bannedPackage config.BannedPackage) bool {
+		return utils.MatchesNamePattern(bannedPackage.Package, packageName)
+	})
+}
+
+func (analyzer *BannedPackageAnalyzer) processDirectReference(project *PackageInfo, packageName string, bannedPackage config.BannedPackage) {
+	reason := fmt.Sprintf(`Package "%s" is banned%s`, packageName, formatBanDetails(bannedPackage, packageName))
+	analyzer.results.AddSuggestion(project.Name, reason)
+
+	replacementName := getReplacementName(bannedPackage, packageName)
+	if replacementName == "" || bannedPackage.Version == "" {
+		return
+	}
+
+	dependency, exists := utils.FirstOrDefault(project.Dependencies, func(dependency *PackageInfo) bool {
+		return dependency.Name == packageName
+	})
+	if exists {
+		action := actions.NewReplacePackageAction(project.Name, dependency, replacementName, bannedPackage.Version, reason)
+		analyzer.results.AddAction(action)
+	}
+}
+
+func formatBanDetails(bannedPackage config.BannedPackage, packageName string) string {
+	details := strings.Builder{}
+	if bannedPackage.Reason != "" {
+		details.WriteString(fmt.Sprintf(" (%s)", bannedPackage.Reason))
+	}
+	replacementName := getReplacementName(bannedPackage, packageName)
+	if replacementName != "" {
+		details.WriteString(fmt.Sprintf(`, use "%s" instead`, replacementName))
+	}
+	return details.String()
+}
+
+// Returns the name of the replacement package (or "" if there's none or it can't be determined)
+func getReplacementName(bannedPackage config.BannedPackage, packageName string) string {
+	replacement := bannedPackage.Replacement
+	replacementPrefix, hasWildcard := strings.CutSuffix(replacement, "*")
+	if hasWildcard {
+		//Keep the suffix matched by the package pattern (only supported for a single trailing wildcard)
+		packagePrefix, isPrefixPattern := strings.CutSuffix(bannedPackage.Package, "*")
+		if !isPrefixPattern || strings.ContainsAny(packagePrefix, "*?[") {
+			return ""
+		}
+		replacement = replacementPrefix + packageName[len(packagePrefix):]
+	}
+
+	if strings.ContainsAny(replacement, "*?[") {
+		return ""
+	}
+	return replacement
+}
@@ -8,12 +8,14 @@ import (
 )
 
 type BubbleUpAnalyzer struct {
-	results *AnalysisResults
+	results          *AnalysisResults
+	globalReferences map[string]string
 }
 
-func NewBubbleUpAnalyzer(collector *AnalysisResults) *BubbleUpAnalyzer {
+func NewBubbleUpAnalyzer(collector *AnalysisResults, projectHandler ProjectHandler) *BubbleUpAnalyzer {
 	return &BubbleUpAnalyzer{
-		results: collector,
+		results:          collector,
+		globalReferences: projectHandler.GetGlobalReferences(),
 	}
 }
 
@@ -56,6 +58,12 @@ func (analyzer *BubbleUpAnalyzer) buildDependencyProjects(dependencyProjects map
 			//Do not consider tools or executable projects
 			continue
 		}
+
+		_, isGlobalReference := analyzer.globalReferences[dependency.Name]
+		if isGlobalReference {
+			//Global package references already apply to every project
+			continue
+		}
 		projectList := dependencyProjects[dependency]
 		dependencyProjects[dependency] = append(projectList, project)
 	}
@@ -78,12 +86,24 @@ func (analyzer *BubbleUpAnalyzer) addBubbleUpActions(dependency *PackageInfo, co
 			continue
 		}
 
+		if !ancestor.IsTestProject() && containsTestProject(projectList) {
+			//Test dependencies must never leak into production projects
+			continue
+		}
+
 		projectNames := extractProjectNames(projectList)
 		action := actions.NewBubbleUpAction(projectNames, ancestor.Name, dependency)
 		analyzer.results.AddAction(action)
 	}
 }
 
+func containsTestProject(projects []*PackageInfo) bool {
+	_, exists := utils.FirstOrDefault(projects, func(project *PackageInfo) bool {
+		return project.IsTestProject()
+	})
+	return exists
+}
+
 func extractProjectNames(projects []*PackageInfo) []string {
 	projectNames := utils.Map(projects, func(project *PackageInfo) string {
 		return project.Name
@@ -0,0 +1,148 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/utils"
+	"sort"
+	"strings"
+)
+
+// CentralPackageConsistencyAnalyzer checks solutions using Central Package Management for package references declaring
+// a local version (NuGet's NU1008), packages without a central version (NuGet's NU1010), unnecessary "VersionOverride"s
+// and package references duplicating a "GlobalPackageReference" (NuGet's NU1504)
+type CentralPackageConsistencyAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+}
+
+// missingGlobalPackage is a package referenced by projects without having a central version
+type missingGlobalPackage struct {
+	version       string //Highest version restored by the referencing projects ("" if none got restored)
+	projectNames  []string
+	localVersions map[string]string //ProjectName => Local version (only for projects declaring one)
+}
+
+func NewCentralPackageConsistencyAnalyzer(results *AnalysisResults, projectHandler ProjectHandler) *CentralPackageConsistencyAnalyzer {
+	return &CentralPackageConsistencyAnalyzer{
+		results:        results,
+		projectHandler: projectHandler,
+	}
+}
+
+func (analyzer *CentralPackageConsistencyAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	if !analyzer.projectHandler.UsesCentralPackageManagement() {
+		return
+	}
+
+	globalPackages := analyzer.projectHandler.GetGlobalPackages()
+
+	globalReferences := analyzer.projectHandler.GetGlobalReferences()
+	missingPackages := make(map[string]*missingGlobalPackage)
+	for _, project := range projects {
+		for _, dependency := range project.Dependencies {
+			if dependency.IsProject() {
+				continue
+			}
+
+			globalVersion, isGlobalReference := globalReferences[dependency.Name]
+			if isGlobalReference {
+				analyzer.processGlobalReference(project, dependency, globalVersion)
+				continue
+			}
+
+			centralVersion, isGlobalPackage := globalPackages[dependency.Name]
+			versionOverride := analyzer.projectHandler.GetVersionOverride(project.Name, dependency.Name)
+			localVersion := analyzer.projectHandler.GetLocalVersion(project.Name, dependency.Name)
+			if !isGlobalPackage && versionOverride == "" {
+				//Local versions can only be stripped once the missing central version gets added, see "processMissingPackages()"
+				analyzer.trackMissingPackage(missingPackages, project, dependency, localVersion)
+				continue
+			}
+			if isGlobalPackage && versionOverride != "" && versionOverride == centralVersion {
+				reason := fmt.Sprintf(`Package "%s" overrides its central version with the very same version "%s"`, dependency.Name, versionOverride)
+				analyzer.results.AddSuggestion(project.Name, reason)
+				analyzer.results.AddAction(actions.NewRemoveVersionOverrideAction(project.Name, dependency.Name, versionOverride, reason))
+			}
+
+			if localVersion != "" {
+				analyzer.processLocalVersion(project, dependency.Name, localVersion, centralVersion, isGlobalPackage)
+			}
+		}
+	}
+	analyzer.processMissingPackages(missingPackages)
+}
+
+// The package is already referenced by every project, the project's own reference is merely a duplicate
+func (analyzer *CentralPackageConsistencyAnalyzer) processGlobalReference(project *PackageInfo, dependency *PackageInfo, globalVersion string) {
+	reason := fmt.Sprintf(`Package "%s" is already referenced by every project through a "GlobalPackageReference" ("%s") (NU1504)`, dependency.Name, globalVersion)
+	analyzer.results.AddSuggestion(project.Name, reason)
+	analyzer.results.AddAction(actions.NewRemovePackageAction(project.Name, dependency, true, reason))
+}
+
+func (analyzer *CentralPackageConsistencyAnalyzer) trackMissingPackage(missingPackages map[string]*missingGlobalPackage, project *PackageInfo, dependency *PackageInfo, localVersion string) {
+	missingPackage, exists := missingPackages[dependency.Name]
+	if !exists {
+		missingPackage = &missingGlobalPackage{
+			localVersions: make(map[string]string),
+		}
+		missingPackages[dependency.Name] = missingPackage
+	}
+
+	missingPackage.projectNames = append(missingPackage.projectNames, project.Name)
+	if localVersion != "" {
+		missingPackage.localVersions[project.Name] = localVersion
+	}
+	if !dependency.IsUnresolved() && (missingPackage.version == "" || utils.IsVersionHigher(dependency.Version, missingPackage.version)) {
+		missingPackage.version = dependency.Version
+	}
+}
+
+func (analyzer *CentralPackageConsistencyAnalyzer) processLocalVersion(project *PackageInfo, packageName string, localVersion string, centralVersion string, isGlobalPackage bool) {
+	if !isGlobalPackage {
+		//The project's "VersionOverride" already provides the version
+		analyzer.addStripLocalVersion(project.Name, packageName, localVersion, true)
+		return
+	}
+
+	reason := fmt.Sprintf(`Package "%s" declares the local version "%s", although its version is managed centrally ("%s") (NU1008)`, packageName, localVersion, centralVersion)
+	analyzer.results.AddSuggestion(project.Name, reason)
+
+	//Matching versions can simply be removed, while different ones were most likely meant to override the central version
+	isSameVersion := localVersion == centralVersion
+	analyzer.results.AddAction(actions.NewStripLocalVersionAction(project.Name, packageName, localVersion, isSameVersion, reason))
+	analyzer.results.AddAction(actions.NewConvertToVersionOverrideAction(project.Name, packageName, localVersion, !isSameVersion, reason))
+}
+
+func (analyzer *CentralPackageConsistencyAnalyzer) addStripLocalVersion(projectName string, packageName string, localVersion string, recommended bool) {
+	reason := fmt.Sprintf(`Package "%s" declares the local version "%s", although the solution manages package versions centrally (NU1008)`, packageName, localVersion)
+	analyzer.results.AddSuggestion(projectName, reason)
+	analyzer.results.AddAction(actions.NewStripLocalVersionAction(projectName, packageName, localVersion, recommended, reason))
+}
+
+func (analyzer *CentralPackageConsistencyAnalyzer) processMissingPackages(missingPackages map[string]*missingGlobalPackage) {
+	workspaceName := analyzer.projectHandler.GetWorkspaceName()
+	packageNames := utils.GetMapKeys(missingPackages)
+	sort.Strings(packageNames)
+	for _, packageName := range packageNames {
+		missingPackage := missingPackages[packageName]
+		canAddGlobalPackage := missingPackage.version != ""
+		for _, projectName := range missingPackage.projectNames {
+			localVersion, exists := missingPackage.localVersions[projectName]
+			if exists {
+				//Stripping without adding the central version would leave the package without any version
+				analyzer.addStripLocalVersion(projectName, packageName, localVersion, canAddGlobalPackage)
+			}
+		}
+
+		reason := fmt.Sprintf(`Package "%s" (referenced by "%s") has no central version (NU1010)`, packageName, strings.Join(missingPackage.projectNames, `", "`))
+		if !canAddGlobalPackage {
+			analyzer.results.AddSuggestion(workspaceName, reason+". No version found in the NuGet cache")
+			continue
+		}
+
+		analyzer.results.AddSuggestion(workspaceName, reason)
+		action := actions.NewAddGlobalPackageAction(packageName, missingPackage.version, reason)
+		analyzer.results.AddAction(action)
+	}
+}
@@ -0,0 +1,83 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/config"
+	"redun-pendancy/utils"
+	"sort"
+)
+
+type CentralPackageMigrationAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+	versionPolicy  config.VersionPolicy
+}
+
+func NewCentralPackageMigrationAnalyzer(collector *AnalysisResults, projectHandler ProjectHandler, versionPolicy config.VersionPolicy) *CentralPackageMigrationAnalyzer {
+	return &CentralPackageMigrationAnalyzer{
+		results:        collector,
+		projectHandler: projectHandler,
+		versionPolicy:  versionPolicy,
+	}
+}
+
+func (analyzer *CentralPackageMigrationAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	if analyzer.projectHandler.UsesCentralPackageManagement() {
+		//Package versions are already managed centrally
+		return
+	}
+
+	packageReferences := collectPackageReferences(projects)
+	if len(packageReferences) == 0 {
+		return
+	}
+
+	conflictCount := 0
+	packageVersions := make(map[string]string)
+	workspaceName := analyzer.projectHandler.GetWorkspaceName()
+	packageNames := utils.GetMapKeys(packageReferences)
+	sort.Strings(packageNames)
+	for _, packageName := range packageNames {
+		references := packageReferences[packageName]
+		version, hasConflict := pickPolicyVersion(references, analyzer.versionPolicy)
+		packageVersions[packageName] = version
+		if hasConflict {
+			conflictCount++
+			suggestion := fmt.Sprintf(`Central version of "%s" will be "%s" ("%s" policy)`, packageName, version, analyzer.versionPolicy)
+			analyzer.results.AddSuggestion(workspaceName, suggestion)
+		}
+	}
+
+	action := actions.NewMigrateCentralPackagesAction(packageVersions, conflictCount, string(analyzer.versionPolicy))
+	analyzer.results.AddAction(action)
+}
+
+// Returns the version picked by the policy, and whether the references had different versions
+func pickPolicyVersion(references []packageReference, versionPolicy config.VersionPolicy) (string, bool) {
+	versionCounts := make(map[string]int)
+	for _, reference := range references {
+		versionCounts[reference.Dependency.Version]++
+	}
+
+	versions := utils.GetMapKeys(versionCounts)
+	sort.Slice(versions, func(i, j int) bool {
+		//Sort by __descending__ version
+		return utils.IsVersionHigher(versions[i], versions[j])
+	})
+
+	hasConflict := len(versions) > 1
+	switch versionPolicy {
+	case config.VersionPolicy_Lowest:
+		return versions[len(versions)-1], hasConflict
+	case config.VersionPolicy_MostUsed:
+		mostUsed := versions[0]
+		for _, version := range versions[1:] {
+			if versionCounts[version] > versionCounts[mostUsed] {
+				mostUsed = version
+			}
+		}
+		return mostUsed, hasConflict
+	}
+	return versions[0], hasConflict
+}
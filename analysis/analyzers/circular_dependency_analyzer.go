@@ -0,0 +1,25 @@
+package analyzers
+
+import (
+	"redun-pendancy/helpers"
+)
+
+type CircularDependencyAnalyzer struct {
+	results       *AnalysisResults
+	workspaceName string
+}
+
+func NewCircularDependencyAnalyzer(results *AnalysisResults, projectHandler ProjectHandler) *CircularDependencyAnalyzer {
+	return &CircularDependencyAnalyzer{
+		results:       results,
+		workspaceName: projectHandler.GetWorkspaceName(),
+	}
+}
+
+func (analyzer *CircularDependencyAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	cycles := helpers.FindDependencyCycles(projects)
+	for _, cycle := range cycles {
+		suggestion := "Circular dependency detected: " + cycle.FormatPath()
+		analyzer.results.AddSuggestion(analyzer.workspaceName, suggestion)
+	}
+}
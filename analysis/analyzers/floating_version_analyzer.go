@@ -0,0 +1,78 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/models"
+	"redun-pendancy/utils"
+)
+
+// FloatingVersionAnalyzer reports package versions that do not restore reproducibly: floating versions (eg: "1.*"),
+// ranges without an upper bound (eg: "[1.0,)") & missing versions
+type FloatingVersionAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+}
+
+func NewFloatingVersionAnalyzer(results *AnalysisResults, projectHandler ProjectHandler) *FloatingVersionAnalyzer {
+	return &FloatingVersionAnalyzer{
+		results:        results,
+		projectHandler: projectHandler,
+	}
+}
+
+func (analyzer *FloatingVersionAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	globalPackages := analyzer.projectHandler.GetGlobalPackages()
+	usesCentralPackages := analyzer.projectHandler.UsesCentralPackageManagement()
+	reportedGlobalPackages := utils.NewSet[string]()
+	for _, project := range projects {
+		for _, dependency := range project.Dependencies {
+			if dependency.IsProject() {
+				continue
+			}
+
+			//Overridden central versions belong to the project itself
+			_, isGlobalPackage := globalPackages[dependency.Name]
+			hasVersionOverride := analyzer.projectHandler.GetVersionOverride(project.Name, dependency.Name) != ""
+			if usesCentralPackages && !isGlobalPackage && !hasVersionOverride {
+				//Packages without a central version are reported by the "CentralPackageConsistencyAnalyzer"
+				continue
+			}
+			isGlobalPackage = isGlobalPackage && !hasVersionOverride
+			if isGlobalPackage && !reportedGlobalPackages.Add(dependency.Name) {
+				//Centrally managed versions only need to be pinned once
+				continue
+			}
+
+			declaredVersion := analyzer.projectHandler.GetDeclaredVersion(project.Name, dependency.Name)
+			versionSpec := models.ParseVersionSpec(declaredVersion)
+			if !versionSpec.IsReproducible() {
+				subject := utils.TernarySelect(isGlobalPackage, analyzer.projectHandler.GetWorkspaceName(), project.Name)
+				analyzer.processVersionSpec(project, dependency, versionSpec, subject, isGlobalPackage)
+			}
+		}
+	}
+}
+
+func (analyzer *FloatingVersionAnalyzer) processVersionSpec(project *PackageInfo, dependency *PackageInfo, versionSpec *models.VersionSpec, subject string, isGlobalPackage bool) {
+	reason := ""
+	switch versionSpec.Kind {
+	case models.VersionSpecKind_Floating:
+		reason = fmt.Sprintf(`Package "%s" uses the floating version "%s"`, dependency.Name, versionSpec.Text)
+	case models.VersionSpecKind_Unbounded:
+		reason = fmt.Sprintf(`Package "%s" uses the version range "%s", which has no upper bound`, dependency.Name, versionSpec.Text)
+	case models.VersionSpecKind_Missing:
+		reason = fmt.Sprintf(`Package "%s" has no version`, dependency.Name)
+	default:
+		reason = fmt.Sprintf(`Package "%s" uses the invalid version "%s"`, dependency.Name, versionSpec.Text)
+	}
+
+	if dependency.IsUnresolved() || versionSpec.Kind == models.VersionSpecKind_Invalid {
+		analyzer.results.AddSuggestion(subject, reason+". No matching version found in the NuGet cache")
+		return
+	}
+
+	analyzer.results.AddSuggestion(subject, fmt.Sprintf(`%s. Restored version: "%s"`, reason, dependency.Version))
+	action := actions.NewPinPackageVersionAction(project.Name, dependency, versionSpec.Text, isGlobalPackage, reason)
+	analyzer.results.AddAction(action)
+}
@@ -0,0 +1,84 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/handlers/base"
+	"redun-pendancy/models"
+	"strings"
+)
+
+// FrameworkCompatibilityAnalyzer checks every project & package reference against the target framework of the project
+type FrameworkCompatibilityAnalyzer struct {
+	results        *AnalysisResults
+	packageManager base.PackageManager
+}
+
+func NewFrameworkCompatibilityAnalyzer(results *AnalysisResults, projectHandler ProjectHandler) *FrameworkCompatibilityAnalyzer {
+	return &FrameworkCompatibilityAnalyzer{
+		results:        results,
+		packageManager: projectHandler.GetPackageManager(),
+	}
+}
+
+func (analyzer *FrameworkCompatibilityAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	for _, project := range projects {
+		for _, dependency := range project.Dependencies {
+			if dependency.IsProject() {
+				analyzer.checkProjectReference(project, dependency)
+			} else if !dependency.IsTool() {
+				analyzer.checkPackageReference(project, dependency)
+			}
+		}
+	}
+}
+
+func (analyzer *FrameworkCompatibilityAnalyzer) checkProjectReference(project *PackageInfo, dependency *PackageInfo) {
+	compatibility := analyzer.packageManager.CheckFrameworkCompatibility(project.Framework, dependency.Framework)
+	switch compatibility {
+	case models.FrameworkCompatibility_Incompatible:
+		suggestion := fmt.Sprintf(`Project reference "%s" targets "%s", which is not compatible with "%s"`,
+			dependency.Name, dependency.Framework, project.Framework,
+		)
+		analyzer.results.AddSuggestion(project.Name, suggestion)
+
+	case models.FrameworkCompatibility_FallbackOnly:
+		suggestion := fmt.Sprintf(`Project reference "%s" targets "%s", which "%s" can only use as a fallback (NU1702)`,
+			dependency.Name, dependency.Framework, project.Framework,
+		)
+		analyzer.results.AddSuggestion(project.Name, suggestion)
+	}
+}
+
+func (analyzer *FrameworkCompatibilityAnalyzer) checkPackageReference(project *PackageInfo, dependency *PackageInfo) {
+	frameworks := analyzer.packageManager.GetSupportedFrameworks(dependency.Name, dependency.Version)
+	if len(frameworks) == 0 {
+		//Either not restored or framework agnostic (eg: no "lib" folder), nothing to check
+		return
+	}
+
+	bestCompatibility := models.FrameworkCompatibility_Incompatible
+	for _, framework := range frameworks {
+		compatibility := analyzer.packageManager.CheckFrameworkCompatibility(project.Framework, framework)
+		if compatibility == models.FrameworkCompatibility_Compatible || compatibility == models.FrameworkCompatibility_Unknown {
+			//Unknown frameworks (eg: "native", "monoandroid") get the benefit of the doubt
+			return
+		}
+		if compatibility == models.FrameworkCompatibility_FallbackOnly {
+			bestCompatibility = compatibility
+		}
+	}
+
+	supportedFrameworks := `"` + strings.Join(frameworks, `", "`) + `"`
+	if bestCompatibility == models.FrameworkCompatibility_FallbackOnly {
+		suggestion := fmt.Sprintf(`Package "%s" (%s) supports %s, which "%s" can only use as a fallback (NU1701)`,
+			dependency.Name, dependency.Version, supportedFrameworks, project.Framework,
+		)
+		analyzer.results.AddSuggestion(project.Name, suggestion)
+		return
+	}
+
+	suggestion := fmt.Sprintf(`Package "%s" (%s) supports %s, none of which is compatible with "%s"`,
+		dependency.Name, dependency.Version, supportedFrameworks, project.Framework,
+	)
+	analyzer.results.AddSuggestion(project.Name, suggestion)
+}
@@ -0,0 +1,138 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/handlers/base"
+	"redun-pendancy/models"
+	"redun-pendancy/utils"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Projects get warned about an upcoming end of support within this period
+const endOfSupportWarningDays = 180
+
+// FrameworkLifecycleAnalyzer reports projects targeting frameworks past (or close to) their end of support,
+// along with projects lagging behind the framework most of the solution targets
+type FrameworkLifecycleAnalyzer struct {
+	results        *AnalysisResults
+	packageManager base.PackageManager
+	today          time.Time
+}
+
+func NewFrameworkLifecycleAnalyzer(results *AnalysisResults, projectHandler ProjectHandler) *FrameworkLifecycleAnalyzer {
+	return &FrameworkLifecycleAnalyzer{
+		results:        results,
+		packageManager: projectHandler.GetPackageManager(),
+		today:          time.Now().Truncate(24 * time.Hour),
+	}
+}
+
+func (analyzer *FrameworkLifecycleAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	mainFramework := analyzer.findMainFramework(projects)
+	for _, project := range projects {
+		endOfSupport, hasEndOfSupport := analyzer.getEndOfSupport(project.Framework)
+		if hasEndOfSupport && !endOfSupport.After(analyzer.today) {
+			reason := fmt.Sprintf(`Target framework "%s" reached its end of support on %s`, project.Framework, endOfSupport.Format(time.DateOnly))
+			analyzer.results.AddSuggestion(project.Name, reason)
+			analyzer.addRetargetAction(project, analyzer.selectRetargetFramework(project.Framework, mainFramework), reason)
+			continue
+		}
+
+		if hasEndOfSupport && endOfSupport.Before(analyzer.today.AddDate(0, 0, endOfSupportWarningDays)) {
+			suggestion := fmt.Sprintf(`Target framework "%s" reaches its end of support on %s`, project.Framework, endOfSupport.Format(time.DateOnly))
+			analyzer.results.AddSuggestion(project.Name, suggestion)
+		}
+
+		if analyzer.isUpgrade(project.Framework, mainFramework) {
+			reason := fmt.Sprintf(`Target framework "%s" is out of line with the rest of the solution (mostly "%s")`, project.Framework, mainFramework)
+			analyzer.results.AddSuggestion(project.Name, reason)
+			analyzer.addRetargetAction(project, mainFramework, reason)
+		}
+	}
+}
+
+func (analyzer *FrameworkLifecycleAnalyzer) getEndOfSupport(framework string) (time.Time, bool) {
+	endOfSupport := analyzer.packageManager.GetFrameworkEndOfSupport(framework)
+	if endOfSupport == "" {
+		return time.Time{}, false
+	}
+
+	date, err := time.Parse(time.DateOnly, endOfSupport)
+	return date, err == nil
+}
+
+// Returns the framework targeted by most projects (ties go to the newest one)
+func (analyzer *FrameworkLifecycleAnalyzer) findMainFramework(projects []*PackageInfo) string {
+	frameworkCounts := make(map[string]int)
+	for _, project := range projects {
+		frameworkCounts[project.Framework]++
+	}
+
+	frameworks := utils.GetMapKeys(frameworkCounts)
+	sort.Strings(frameworks)
+	mainFramework := ""
+	for _, framework := range frameworks {
+		count, mainCount := frameworkCounts[framework], frameworkCounts[mainFramework]
+		if count > mainCount || (count == mainCount && analyzer.isUpgrade(mainFramework, framework)) {
+			mainFramework = framework
+		}
+	}
+	return mainFramework
+}
+
+// Checks whether "newFramework" is a newer version of the same framework family (eg: "net8.0" for "net6.0", but not for "netstandard2.0")
+func (analyzer *FrameworkLifecycleAnalyzer) isUpgrade(framework string, newFramework string) bool {
+	if framework == newFramework || newFramework == "" {
+		return false
+	}
+
+	latestFramework := analyzer.packageManager.GetLatestLtsFramework(framework)
+	if latestFramework == "" || latestFramework != analyzer.packageManager.GetLatestLtsFramework(newFramework) {
+		return false
+	}
+	return analyzer.packageManager.CheckFrameworkCompatibility(newFramework, framework) == models.FrameworkCompatibility_Compatible
+}
+
+// Prefers the main framework of the solution (when still supported), over the latest LTS framework of the same family
+func (analyzer *FrameworkLifecycleAnalyzer) selectRetargetFramework(framework string, mainFramework string) string {
+	endOfSupport, hasEndOfSupport := analyzer.getEndOfSupport(mainFramework)
+	if analyzer.isUpgrade(framework, mainFramework) && (!hasEndOfSupport || endOfSupport.After(analyzer.today)) {
+		return mainFramework
+	}
+	return analyzer.packageManager.GetLatestLtsFramework(framework)
+}
+
+func (analyzer *FrameworkLifecycleAnalyzer) addRetargetAction(project *PackageInfo, framework string, reason string) {
+	if !analyzer.isUpgrade(project.Framework, framework) {
+		return
+	}
+
+	blockers := analyzer.findRetargetBlockers(project, framework)
+	if len(blockers) != 0 {
+		suggestion := fmt.Sprintf(`Retargeting to "%s" is blocked by: %s`, framework, strings.Join(blockers, ", "))
+		analyzer.results.AddSuggestion(project.Name, suggestion)
+		return
+	}
+
+	action := actions.NewRetargetProjectAction(project.Name, project.Framework, framework, reason)
+	analyzer.results.AddAction(action)
+}
+
+// Returns the packages without assets for the new framework, along with the referencing projects that could no longer consume the project
+func (analyzer *FrameworkLifecycleAnalyzer) findRetargetBlockers(project *PackageInfo, framework string) []string {
+	blockers := []string{}
+	for _, packageInfo := range analyzer.packageManager.FindIncompatiblePackages(project, framework) {
+		blockers = append(blockers, fmt.Sprintf(`package "%s" (%s)`, packageInfo.Name, packageInfo.Version))
+	}
+
+	for _, parent := range project.Parents {
+		compatibility := analyzer.packageManager.CheckFrameworkCompatibility(parent.Framework, framework)
+		if parent.IsProject() && compatibility != models.FrameworkCompatibility_Compatible {
+			blockers = append(blockers, fmt.Sprintf(`project "%s" (%s)`, parent.Name, parent.Framework))
+		}
+	}
+	return blockers
+}
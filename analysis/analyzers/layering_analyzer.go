@@ -0,0 +1,107 @@
+package analyzers
+
+import (
+	"fmt"
+	"path/filepath"
+	"redun-pendancy/analysis"
+	"redun-pendancy/config"
+	"redun-pendancy/utils"
+	"sort"
+	"strings"
+)
+
+// LayeringAnalyzer reports project & package references (direct or transitive) forbidden by the configured layering rules
+type LayeringAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+	rules          []config.LayeringRule
+}
+
+func NewLayeringAnalyzer(results *AnalysisResults, projectHandler ProjectHandler, layeringConfig config.LayeringConfig) *LayeringAnalyzer {
+	return &LayeringAnalyzer{
+		results:        results,
+		projectHandler: projectHandler,
+		rules:          layeringConfig.Rules,
+	}
+}
+
+func (analyzer *LayeringAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	if len(analyzer.rules) == 0 {
+		return
+	}
+
+	ancestryMap := analysis.NewAncestryMap(projects)
+	for _, project := range projects {
+		for _, rule := range analyzer.rules {
+			if !isRuleApplicable(rule, project) {
+				continue
+			}
+			analyzer.checkProjectReferences(project, rule, ancestryMap)
+			analyzer.checkPackageReferences(project, rule)
+		}
+	}
+}
+
+func isRuleApplicable(rule config.LayeringRule, project *PackageInfo) bool {
+	projectName := getLayeringName(project)
+	if !utils.MatchesNamePattern(rule.From, projectName) {
+		return false
+	}
+	for _, exceptPattern := range rule.Except {
+		if utils.MatchesNamePattern(exceptPattern, projectName) {
+			return false
+		}
+	}
+	return true
+}
+
+// Project names are matched without their extension (eg: "Acme.Domain.csproj" => "Acme.Domain")
+func getLayeringName(packageInfo *PackageInfo) string {
+	if packageInfo.IsProject() {
+		return strings.TrimSuffix(packageInfo.Name, filepath.Ext(packageInfo.Name))
+	}
+	return packageInfo.Name
+}
+
+func (analyzer *LayeringAnalyzer) checkProjectReferences(project *PackageInfo, rule config.LayeringRule, ancestryMap *analysis.AncestryMap) {
+	referencedProjects := utils.GetMapKeys(ancestryMap.GetAncestors(project))
+	sort.Slice(referencedProjects, func(i, j int) bool {
+		return referencedProjects[i].Name < referencedProjects[j].Name
+	})
+
+	resolution := analyzer.projectHandler.GetResolution(project)
+	for _, referencedProject := range referencedProjects {
+		if !utils.MatchesNamePattern(rule.Forbidden, getLayeringName(referencedProject)) {
+			continue
+		}
+
+		resolvedProject, exists := resolution.Resolved[referencedProject.Name]
+		if !exists {
+			//Should not happen, every referenced project is part of the closure
+			analyzer.addViolation(project, rule, referencedProject.Name, false, referencedProject.Name)
+			continue
+		}
+		analyzer.addViolation(project, rule, referencedProject.Name, resolvedProject.Depth == 1, resolvedProject.FormatPath())
+	}
+}
+
+func (analyzer *LayeringAnalyzer) checkPackageReferences(project *PackageInfo, rule config.LayeringRule) {
+	resolution := analyzer.projectHandler.GetResolution(project)
+	packageNames := utils.GetMapKeys(resolution.Resolved)
+	sort.Strings(packageNames)
+	for _, packageName := range packageNames {
+		resolvedPackage := resolution.Resolved[packageName]
+		if resolvedPackage.PackageInfo.IsProject() || !utils.MatchesNamePattern(rule.Forbidden, packageName) {
+			continue
+		}
+		analyzer.addViolation(project, rule, packageName, resolvedPackage.Depth == 1, resolvedPackage.FormatPath())
+	}
+}
+
+func (analyzer *LayeringAnalyzer) addViolation(project *PackageInfo, rule config.LayeringRule, referenceName string, isDirect bool, path string) {
+	referenceType := utils.TernarySelect(isDirect, "directly", "transitively")
+	suggestion := fmt.Sprintf(`Layering rule "%s" => "%s" violated: "%s" is referenced %s. Path: "%s/%s"`,
+		rule.From, rule.Forbidden, referenceName, referenceType, project.Name, path,
+	)
+	analyzer.results.AddSuggestion(project.Name, suggestion)
+}
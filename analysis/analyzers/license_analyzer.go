@@ -0,0 +1,105 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/config"
+	"redun-pendancy/utils"
+	"sort"
+	"strings"
+)
+
+const nugetLicenseUrlPrefix = "https://licenses.nuget.org/"
+
+// LicenseAnalyzer checks the license of every package in each project's closure against the configured allow/deny lists
+type LicenseAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+	allowed        utils.Set[string] //Lower case
+	denied         utils.Set[string] //Lower case
+}
+
+func NewLicenseAnalyzer(results *AnalysisResults, projectHandler ProjectHandler, licensesConfig config.LicensesConfig) *LicenseAnalyzer {
+	return &LicenseAnalyzer{
+		results:        results,
+		projectHandler: projectHandler,
+		allowed:        createLowerCaseSet(licensesConfig.Allowed),
+		denied:         createLowerCaseSet(licensesConfig.Denied),
+	}
+}
+
+func createLowerCaseSet(items []string) utils.Set[string] {
+	set := utils.NewSet[string]()
+	for _, item := range items {
+		set.Add(strings.ToLower(strings.TrimSpace(item)))
+	}
+	return set
+}
+
+func (analyzer *LicenseAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	if analyzer.allowed.IsEmpty() && analyzer.denied.IsEmpty() {
+		//Not configured
+		return
+	}
+
+	for _, project := range projects {
+		resolution := analyzer.projectHandler.GetResolution(project)
+		packageNames := utils.GetMapKeys(resolution.Resolved)
+		sort.Strings(packageNames)
+		for _, packageName := range packageNames {
+			resolvedPackage := resolution.Resolved[packageName]
+			packageInfo := resolvedPackage.PackageInfo
+			if packageInfo.IsProject() {
+				continue
+			}
+
+			violation := analyzer.checkLicense(packageInfo.GetLicense())
+			if violation != "" {
+				suggestion := fmt.Sprintf(`Package "%s" (%s) %s. Brought in by: "%s/%s"`,
+					packageInfo.Name, packageInfo.Version, violation, project.Name, resolvedPackage.FormatPath(),
+				)
+				analyzer.results.AddSuggestion(project.Name, suggestion)
+			}
+		}
+	}
+}
+
+// Returns the reason why the license is not compliant (or "" if it is)
+func (analyzer *LicenseAnalyzer) checkLicense(license string) string {
+	if license == "" {
+		return utils.TernarySelect(analyzer.allowed.IsEmpty(), "", "has no license information")
+	}
+
+	expression, _ := utils.TrimPrefixIfMatch(license, nugetLicenseUrlPrefix)
+	if strings.HasPrefix(expression, "file:") && analyzer.allowed.IsEmpty() {
+		//Embedded license files can't be checked, only an allow list rejects them
+		return ""
+	}
+
+	for _, alternative := range splitLicenseExpression(expression, " OR ") {
+		if analyzer.isAlternativeAllowed(alternative) {
+			return ""
+		}
+	}
+	return fmt.Sprintf(`license "%s" is not allowed`, license)
+}
+
+// Every license of an alternative (eg: "MIT AND BSD-3-Clause") must be allowed
+func (analyzer *LicenseAnalyzer) isAlternativeAllowed(alternative string) bool {
+	for _, term := range splitLicenseExpression(alternative, " AND ") {
+		term = strings.ToLower(term)
+		if analyzer.denied.Contains(term) {
+			return false
+		}
+		if !analyzer.allowed.IsEmpty() && !analyzer.allowed.Contains(term) {
+			return false
+		}
+	}
+	return true
+}
+
+// NOTE: Parentheses are ignored, which is good enough for the expressions found in practice (eg: "(MIT OR Apache-2.0)")
+func splitLicenseExpression(expression string, operator string) []string {
+	expression = strings.NewReplacer("(", "", ")", "").Replace(expression)
+	terms := strings.Split(expression, operator)
+	return utils.Map(terms, strings.TrimSpace)
+}
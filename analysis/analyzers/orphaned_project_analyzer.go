@@ -0,0 +1,31 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+)
+
+// OrphanedProjectAnalyzer finds libraries that no other project references (executables & test projects have no consumers by design)
+type OrphanedProjectAnalyzer struct {
+	results       *AnalysisResults
+	workspaceName string
+}
+
+func NewOrphanedProjectAnalyzer(results *AnalysisResults, projectHandler ProjectHandler) *OrphanedProjectAnalyzer {
+	return &OrphanedProjectAnalyzer{
+		results:       results,
+		workspaceName: projectHandler.GetWorkspaceName(),
+	}
+}
+
+func (analyzer *OrphanedProjectAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	for _, project := range projects {
+		if project.IsExeProject() || project.IsTestProject() || len(project.Parents) != 0 {
+			continue
+		}
+
+		reason := fmt.Sprintf(`Project "%s" is not referenced by any other project`, project.Name)
+		action := actions.NewRemoveProjectAction(project.Name, analyzer.workspaceName, reason)
+		analyzer.results.AddAction(action)
+	}
+}
@@ -0,0 +1,64 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/utils"
+	"sort"
+)
+
+// PackageDowngradeAnalyzer finds direct package references with a lower version than the one required transitively (NuGet's NU1605)
+type PackageDowngradeAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+}
+
+func NewPackageDowngradeAnalyzer(collector *AnalysisResults, projectHandler ProjectHandler) *PackageDowngradeAnalyzer {
+	return &PackageDowngradeAnalyzer{
+		results:        collector,
+		projectHandler: projectHandler,
+	}
+}
+
+func (analyzer *PackageDowngradeAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	for _, project := range projects {
+		resolution := analyzer.projectHandler.GetResolution(project)
+		downgrades := collectDowngrades(project, resolution)
+
+		packageNames := utils.GetMapKeys(downgrades)
+		sort.Strings(packageNames)
+		for _, packageName := range packageNames {
+			analyzer.addDowngradeResults(project, downgrades[packageName])
+		}
+	}
+}
+
+// Keeps the highest eliminated requirement of every package reference the project declares (transitive downgrades have to be fixed where they are introduced)
+func collectDowngrades(project *PackageInfo, resolution *ProjectResolution) map[string]*VersionDowngrade {
+	downgrades := make(map[string]*VersionDowngrade)
+	for _, downgrade := range resolution.Downgrades {
+		resolvedPackage := downgrade.Resolved
+		if !resolvedPackage.PackageInfo.IsPackage() || !resolvedPackage.IsDeclaredBy(project) {
+			continue
+		}
+
+		packageName := resolvedPackage.PackageInfo.Name
+		existingDowngrade, exists := downgrades[packageName]
+		if !exists || utils.IsVersionHigher(downgrade.Required.PackageInfo.Version, existingDowngrade.Required.PackageInfo.Version) {
+			downgrades[packageName] = downgrade
+		}
+	}
+	return downgrades
+}
+
+func (analyzer *PackageDowngradeAnalyzer) addDowngradeResults(project *PackageInfo, downgrade *VersionDowngrade) {
+	dependency := downgrade.Resolved.PackageInfo
+	requiredVersion := downgrade.Required.PackageInfo.Version
+	reason := fmt.Sprintf(`Package "%s" is downgraded from %s to %s (NU1605). Required by: "%s/%s"`,
+		dependency.Name, requiredVersion, dependency.Version, project.Name, downgrade.Required.FormatPath(),
+	)
+	analyzer.results.AddSuggestion(project.Name, reason)
+
+	action := actions.NewUpdatePackageVersionAction(project.Name, dependency, requiredVersion, false, reason)
+	analyzer.results.AddAction(action)
+}
@@ -0,0 +1,90 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/config"
+	"redun-pendancy/utils"
+	"sort"
+)
+
+// PrereleaseAnalyzer reports prerelease packages (direct or transitive) used by shipping projects (test projects are excluded)
+type PrereleaseAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+	allowed        []string
+}
+
+func NewPrereleaseAnalyzer(results *AnalysisResults, projectHandler ProjectHandler, prereleaseConfig config.PrereleaseConfig) *PrereleaseAnalyzer {
+	return &PrereleaseAnalyzer{
+		results:        results,
+		projectHandler: projectHandler,
+		allowed:        prereleaseConfig.Allowed,
+	}
+}
+
+func (analyzer *PrereleaseAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	for _, project := range projects {
+		if project.IsTestProject() {
+			continue
+		}
+
+		resolution := analyzer.projectHandler.GetResolution(project)
+		packageNames := utils.GetMapKeys(resolution.Resolved)
+		sort.Strings(packageNames)
+		for _, packageName := range packageNames {
+			resolvedPackage := resolution.Resolved[packageName]
+			packageInfo := resolvedPackage.PackageInfo
+			if packageInfo.IsProject() || !utils.IsPrereleaseVersion(packageInfo.Version) || analyzer.isAllowed(packageName) {
+				continue
+			}
+			analyzer.processPrereleasePackage(project, resolvedPackage)
+		}
+	}
+}
+
+func (analyzer *PrereleaseAnalyzer) isAllowed(packageName string) bool {
+	_, isAllowed := utils.FirstOrDefault(analyzer.allowed, func(pattern string) bool {
+		return utils.MatchesNamePattern(pattern, packageName)
+	})
+	return isAllowed
+}
+
+func (analyzer *PrereleaseAnalyzer) processPrereleasePackage(project *PackageInfo, resolvedPackage *ResolvedPackage) {
+	packageInfo := resolvedPackage.PackageInfo
+	stableVersion := analyzer.findNearestStableVersion(packageInfo.Name, packageInfo.Version)
+	suggestion := fmt.Sprintf(`Prerelease package "%s" (%s) is used. Introduced by: "%s/%s". %s`,
+		packageInfo.Name, packageInfo.Version, project.Name, resolvedPackage.FormatPath(),
+		utils.TernarySelect(stableVersion != "",
+			fmt.Sprintf(`Nearest stable version in the cache: "%s".`, stableVersion),
+			"No stable version found in the cache.",
+		),
+	)
+	analyzer.results.AddSuggestion(project.Name, suggestion)
+
+	if !resolvedPackage.IsDeclaredBy(project) || stableVersion == "" {
+		//Transitive prereleases come from the (stable) package that introduced them, which has to be changed instead
+		return
+	}
+
+	reason := fmt.Sprintf(`Package "%s" uses the prerelease version "%s"`, packageInfo.Name, packageInfo.Version)
+	action := actions.NewUpdatePackageVersionAction(project.Name, packageInfo, stableVersion, false, reason)
+	analyzer.results.AddAction(action)
+}
+
+// Returns the lowest stable version above the prerelease (eg: "2.0.0" for "2.0.0-rc.1") or else the highest one below it
+func (analyzer *PrereleaseAnalyzer) findNearestStableVersion(packageName string, version string) string {
+	packageManager := analyzer.projectHandler.GetPackageManager()
+	nearestVersion := ""
+	for _, availableVersion := range packageManager.GetAvailableVersions(packageName) {
+		if utils.IsPrereleaseVersion(availableVersion) {
+			continue
+		}
+
+		nearestVersion = availableVersion
+		if utils.IsVersionHigher(availableVersion, version) {
+			break
+		}
+	}
+	return nearestVersion
+}
@@ -0,0 +1,86 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/utils"
+	"sort"
+	"strings"
+)
+
+// ProjectPackageAnalyzer finds components that are both built as a project of the solution and consumed as a package
+// (matched by the "PackageId" or "AssemblyName" of the project)
+type ProjectPackageAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+}
+
+func NewProjectPackageAnalyzer(results *AnalysisResults, projectHandler ProjectHandler) *ProjectPackageAnalyzer {
+	return &ProjectPackageAnalyzer{
+		results:        results,
+		projectHandler: projectHandler,
+	}
+}
+
+func (analyzer *ProjectPackageAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	componentProjects := make(map[string]*PackageInfo) //Lowercase identity => Project
+	for _, project := range projects {
+		for _, identity := range project.GetIdentities() {
+			componentProjects[strings.ToLower(identity)] = project
+		}
+	}
+
+	consumedPackages := make(map[*PackageInfo]*PackageInfo) //Project => Highest package version consumed
+	for _, project := range projects {
+		resolution := analyzer.projectHandler.GetResolution(project)
+		packageNames := utils.GetMapKeys(resolution.Resolved)
+		sort.Strings(packageNames)
+		for _, packageName := range packageNames {
+			resolvedPackage := resolution.Resolved[packageName]
+			packageInfo := resolvedPackage.PackageInfo
+			componentProject, exists := componentProjects[strings.ToLower(packageName)]
+			if packageInfo.IsProject() || !exists || componentProject == project {
+				continue
+			}
+
+			consumedPackage, exists := consumedPackages[componentProject]
+			if !exists || utils.IsVersionHigher(packageInfo.Version, consumedPackage.Version) {
+				consumedPackages[componentProject] = packageInfo
+			}
+			analyzer.processConsumedPackage(project, componentProject, resolvedPackage)
+		}
+	}
+
+	for _, project := range projects {
+		for _, dependency := range project.Dependencies {
+			packageInfo, exists := consumedPackages[dependency]
+			if !exists {
+				continue
+			}
+
+			reason := fmt.Sprintf(`Project "%s" is also consumed as package "%s" (%s) in the solution`, dependency.Name, packageInfo.Name, packageInfo.Version)
+			action := actions.NewConvertToPackageReferenceAction(project.Name, dependency, packageInfo, reason)
+			analyzer.results.AddAction(action)
+		}
+	}
+}
+
+func (analyzer *ProjectPackageAnalyzer) processConsumedPackage(project *PackageInfo, componentProject *PackageInfo, resolvedPackage *ResolvedPackage) {
+	packageInfo := resolvedPackage.PackageInfo
+	isStale := componentProject.ProjectVersion != "" && utils.IsVersionHigher(componentProject.ProjectVersion, packageInfo.Version)
+	suggestion := fmt.Sprintf(`Package "%s" (%s) is also built by project "%s" of the solution%s. Introduced by: "%s/%s"`,
+		packageInfo.Name, packageInfo.Version, componentProject.Name,
+		utils.TernarySelect(isStale, fmt.Sprintf(`, which is already at version "%s"`, componentProject.ProjectVersion), ""),
+		project.Name, resolvedPackage.FormatPath(),
+	)
+	analyzer.results.AddSuggestion(project.Name, suggestion)
+
+	if !resolvedPackage.IsDeclaredBy(project) || componentProject.ContainsTransientDependency(project.Name) {
+		//Transitive packages have to be converted where they are referenced, and the conversion must not create a circular dependency
+		return
+	}
+
+	reason := fmt.Sprintf(`Package "%s" (%s) is also built by project "%s" of the solution`, packageInfo.Name, packageInfo.Version, componentProject.Name)
+	action := actions.NewConvertToProjectReferenceAction(project.Name, packageInfo, componentProject, reason)
+	analyzer.results.AddAction(action)
+}
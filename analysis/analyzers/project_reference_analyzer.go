@@ -0,0 +1,57 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/utils"
+)
+
+// ProjectReferenceAnalyzer checks every project reference path (absolute paths, letter case mismatches) and target
+// (projects missing from the solution, executable projects)
+type ProjectReferenceAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+}
+
+func NewProjectReferenceAnalyzer(results *AnalysisResults, projectHandler ProjectHandler) *ProjectReferenceAnalyzer {
+	return &ProjectReferenceAnalyzer{
+		results:        results,
+		projectHandler: projectHandler,
+	}
+}
+
+func (analyzer *ProjectReferenceAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	workspaceName := analyzer.projectHandler.GetWorkspaceName()
+	missingProjects := utils.NewSet[string]()
+	for _, project := range projects {
+		for _, reference := range analyzer.projectHandler.GetProjectReferences(project.Name) {
+			analyzer.checkReferencePath(project, reference)
+
+			referencedProject := reference.Project
+			if !reference.IsInSolution && missingProjects.Add(referencedProject.Name) {
+				reason := fmt.Sprintf(`Project "%s" (referenced by "%s") is not part of "%s"`, referencedProject.Name, project.Name, workspaceName)
+				action := actions.NewAddProjectToSolutionAction(referencedProject.Name, workspaceName, reason)
+				analyzer.results.AddAction(action)
+			}
+
+			if referencedProject.IsExeProject() && !project.IsTestProject() {
+				//Test projects commonly reference the application they test
+				suggestion := fmt.Sprintf(`Project references the executable project "%s". Consider moving the shared code into a library`, referencedProject.Name)
+				analyzer.results.AddSuggestion(project.Name, suggestion)
+			}
+		}
+	}
+}
+
+func (analyzer *ProjectReferenceAnalyzer) checkReferencePath(project *PackageInfo, reference *ProjectReference) {
+	if !reference.NeedsNormalization() {
+		return
+	}
+
+	reason := utils.TernarySelect(reference.IsAbsolute,
+		fmt.Sprintf(`Project reference "%s" uses an absolute path`, reference.IncludePath),
+		fmt.Sprintf(`Project reference "%s" differs in letter case from the file on disk ("%s")`, reference.IncludePath, reference.NormalizedPath),
+	)
+	action := actions.NewNormalizeProjectReferenceAction(project.Name, reference.Project.Name, reference.NormalizedPath, reason)
+	analyzer.results.AddAction(action)
+}
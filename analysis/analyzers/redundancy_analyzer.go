@@ -7,12 +7,14 @@ import (
 )
 
 type RedundancyAnalyzer struct {
-	results *AnalysisResults
+	results          *AnalysisResults
+	globalReferences map[string]string
 }
 
-func NewRedundancyAnalyzer(collector *AnalysisResults) *RedundancyAnalyzer {
+func NewRedundancyAnalyzer(collector *AnalysisResults, projectHandler ProjectHandler) *RedundancyAnalyzer {
 	return &RedundancyAnalyzer{
-		results: collector,
+		results:          collector,
+		globalReferences: projectHandler.GetGlobalReferences(),
 	}
 }
 
@@ -27,6 +29,12 @@ func (analyzer *RedundancyAnalyzer) findRedundantProjectDependencies(project *Pa
 		if dependency.IsTool() {
 			continue
 		}
+
+		_, isGlobalReference := analyzer.globalReferences[dependency.Name]
+		if isGlobalReference {
+			//Duplicates of global package references are reported by the "CentralPackageConsistencyAnalyzer"
+			continue
+		}
 		analyzer.checkProjectDependencyForRedundancy(project, dependency)
 	}
 }
@@ -38,6 +46,7 @@ func (analyzer *RedundancyAnalyzer) checkProjectDependencyForRedundancy(project
 		}
 
 		seenPackages := utils.NewSet[*PackageInfo]()
+		seenPackages.Add(project) //Circular dependencies should not lead back to the project
 		redundantPath, redundantDependency := analyzer.getDependencyPath(dependency, targetDependency.Name, seenPackages)
 		if redundantPath != "" {
 			analyzer.addRemovePackageAction(project, targetDependency, redundantPath, redundantDependency)
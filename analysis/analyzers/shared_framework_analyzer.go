@@ -0,0 +1,41 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/handlers/base"
+)
+
+// SharedFrameworkAnalyzer finds package references already provided by a shared framework of the project (eg: "System.Text.Json" on "net8.0")
+type SharedFrameworkAnalyzer struct {
+	results        *AnalysisResults
+	packageManager base.PackageManager
+}
+
+func NewSharedFrameworkAnalyzer(results *AnalysisResults, projectHandler ProjectHandler) *SharedFrameworkAnalyzer {
+	return &SharedFrameworkAnalyzer{
+		results:        results,
+		packageManager: projectHandler.GetPackageManager(),
+	}
+}
+
+func (analyzer *SharedFrameworkAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	for _, project := range projects {
+		for _, dependency := range project.Dependencies {
+			if dependency.IsProject() {
+				continue
+			}
+
+			sharedFramework := analyzer.packageManager.FindSharedFramework(project, dependency)
+			if sharedFramework == "" {
+				continue
+			}
+
+			reason := fmt.Sprintf(`Package "%s" (%s) is already provided by the shared framework "%s" (%s)`,
+				dependency.Name, dependency.Version, sharedFramework, project.Framework,
+			)
+			action := actions.NewRemovePackageAction(project.Name, dependency, true, reason)
+			analyzer.results.AddAction(action)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/utils"
+	"sort"
+)
+
+// Packages only meant to be used by test projects (test frameworks, mocking & assertion libraries)
+var testPackagePatterns = []string{
+	"Microsoft.NET.Test.Sdk",
+	"xunit", "xunit.*",
+	"NUnit", "NUnit.*", "NUnit3TestAdapter",
+	"MSTest.*",
+	"Moq", "NSubstitute", "FakeItEasy",
+	"FluentAssertions", "Shouldly",
+	"coverlet.*",
+}
+
+// TestLeakageAnalyzer reports production projects that reference (directly or transitively) test projects or test packages
+type TestLeakageAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+}
+
+func NewTestLeakageAnalyzer(results *AnalysisResults, projectHandler ProjectHandler) *TestLeakageAnalyzer {
+	return &TestLeakageAnalyzer{
+		results:        results,
+		projectHandler: projectHandler,
+	}
+}
+
+func (analyzer *TestLeakageAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	for _, project := range projects {
+		if project.IsTestProject() {
+			continue
+		}
+
+		resolution := analyzer.projectHandler.GetResolution(project)
+		packageNames := utils.GetMapKeys(resolution.Resolved)
+		sort.Strings(packageNames)
+		for _, packageName := range packageNames {
+			resolvedPackage := resolution.Resolved[packageName]
+			if isBroughtInByTestProject(resolvedPackage) {
+				//Already reported along with the test project itself
+				continue
+			}
+
+			packageInfo := resolvedPackage.PackageInfo
+			if packageInfo.IsTestProject() {
+				suggestion := fmt.Sprintf(`Production project references the test project "%s". Path: "%s/%s"`, packageName, project.Name, resolvedPackage.FormatPath())
+				analyzer.results.AddSuggestion(project.Name, suggestion)
+				continue
+			}
+
+			if !packageInfo.IsProject() && isTestPackage(packageName) {
+				analyzer.processTestPackage(project, resolvedPackage)
+			}
+		}
+	}
+}
+
+func isBroughtInByTestProject(resolvedPackage *ResolvedPackage) bool {
+	parentPath := resolvedPackage.Path[:len(resolvedPackage.Path)-1]
+	_, exists := utils.FirstOrDefault(parentPath, func(packageInfo *PackageInfo) bool {
+		return packageInfo.IsTestProject()
+	})
+	return exists
+}
+
+func isTestPackage(packageName string) bool {
+	_, exists := utils.FirstOrDefault(testPackagePatterns, func(pattern string) bool {
+		return utils.MatchesNamePattern(pattern, packageName)
+	})
+	return exists
+}
+
+func (analyzer *TestLeakageAnalyzer) processTestPackage(project *PackageInfo, resolvedPackage *ResolvedPackage) {
+	packageInfo := resolvedPackage.PackageInfo
+	suggestion := fmt.Sprintf(`Production project references the test package "%s" (%s). Path: "%s/%s"`,
+		packageInfo.Name, packageInfo.Version, project.Name, resolvedPackage.FormatPath(),
+	)
+	analyzer.results.AddSuggestion(project.Name, suggestion)
+
+	if !resolvedPackage.IsDeclaredBy(project) {
+		return
+	}
+
+	reason := fmt.Sprintf(`Test package "%s" is referenced by the production project "%s"`, packageInfo.Name, project.Name)
+	action := actions.NewRemovePackageAction(project.Name, packageInfo, false, reason)
+	analyzer.results.AddAction(action)
+}
@@ -7,5 +7,9 @@ import (
 )
 
 type PackageInfo = models.PackageInfo
+type ResolvedPackage = models.ResolvedPackage
+type ProjectResolution = models.ProjectResolution
+type VersionDowngrade = models.VersionDowngrade
+type ProjectReference = models.ProjectReference
 type ProjectHandler = base.ProjectHandler
 type AnalysisResults = analysis.AnalysisResults
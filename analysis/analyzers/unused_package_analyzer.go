@@ -0,0 +1,112 @@
+package analyzers
+
+import (
+	"fmt"
+	"log"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/config"
+	"redun-pendancy/utils"
+	"slices"
+	"strings"
+)
+
+// UnusedPackageAnalyzer finds package references whose assemblies no source file of the project seems to use.
+//
+// NOTE: Usage is guessed from the imported namespaces, so the removals are never recommended (eg: packages used through reflection or XAML).
+type UnusedPackageAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+	config         config.UnusedPackagesConfig
+	assemblyNames  map[string][]string //PackageKey => AssemblyNames (including the dependencies)
+}
+
+func NewUnusedPackageAnalyzer(results *AnalysisResults, projectHandler ProjectHandler, unusedPackagesConfig config.UnusedPackagesConfig) *UnusedPackageAnalyzer {
+	return &UnusedPackageAnalyzer{
+		results:        results,
+		projectHandler: projectHandler,
+		config:         unusedPackagesConfig,
+		assemblyNames:  make(map[string][]string),
+	}
+}
+
+func (analyzer *UnusedPackageAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	if !analyzer.config.Enabled {
+		return
+	}
+
+	for _, project := range projects {
+		namespaces, err := analyzer.projectHandler.GetSourceNamespaces(project)
+		if err != nil {
+			log.Printf(`[Warning] Failed to scan the source files of "%s": %v`, project.Name, err)
+			continue
+		}
+
+		for _, dependency := range project.Dependencies {
+			if dependency.IsProject() || dependency.IsTool() || analyzer.isIgnored(dependency.Name) {
+				continue
+			}
+			analyzer.checkDependencyUsage(project, dependency, namespaces)
+		}
+	}
+}
+
+func (analyzer *UnusedPackageAnalyzer) isIgnored(packageName string) bool {
+	_, isIgnored := utils.FirstOrDefault(analyzer.config.Ignored, func(pattern string) bool {
+		return utils.MatchesNamePattern(pattern, packageName)
+	})
+	return isIgnored
+}
+
+func (analyzer *UnusedPackageAnalyzer) checkDependencyUsage(project *PackageInfo, dependency *PackageInfo, namespaces []string) {
+	assemblyNames := analyzer.getAssemblyNames(dependency, utils.NewSet[*PackageInfo]())
+	if len(assemblyNames) == 0 {
+		//Nothing to compare against (eg: build-only or analyzer packages)
+		return
+	}
+
+	for _, namespace := range namespaces {
+		for _, assemblyName := range assemblyNames {
+			if isNamespaceProvided(namespace, assemblyName) {
+				return
+			}
+		}
+	}
+
+	sortedAssemblyNames := slices.Clone(assemblyNames)
+	slices.Sort(sortedAssemblyNames)
+	reason := fmt.Sprintf(`No source file of "%s" uses a namespace of package "%s" (assemblies: %s)`,
+		project.Name, dependency.Name, strings.Join(slices.Compact(sortedAssemblyNames), ", "),
+	)
+	action := actions.NewRemovePackageAction(project.Name, dependency, false, reason)
+	analyzer.results.AddAction(action)
+}
+
+// Returns the assemblies of the package and its dependencies, as those would be removed along with it
+func (analyzer *UnusedPackageAnalyzer) getAssemblyNames(packageInfo *PackageInfo, visited utils.Set[*PackageInfo]) []string {
+	packageKey := packageInfo.Name + "@" + packageInfo.Version
+	assemblyNames, exists := analyzer.assemblyNames[packageKey]
+	if exists {
+		return assemblyNames
+	}
+	if !visited.Add(packageInfo) {
+		return nil
+	}
+
+	packageManager := analyzer.projectHandler.GetPackageManager()
+	assemblyNames = packageManager.GetAssemblyNames(packageInfo.Name, packageInfo.Version)
+	for _, dependency := range packageInfo.Dependencies {
+		assemblyNames = append(assemblyNames, analyzer.getAssemblyNames(dependency, visited)...)
+	}
+	analyzer.assemblyNames[packageKey] = assemblyNames
+	return assemblyNames
+}
+
+// A namespace is plausibly provided by an assembly of the same name, a parent one (eg: "Newtonsoft.Json" for "Newtonsoft.Json.Linq")
+// or a direct child one (eg: "Microsoft.Extensions.Logging.Abstractions" for "Microsoft.Extensions.Logging")
+func isNamespaceProvided(namespace string, assemblyName string) bool {
+	if namespace == assemblyName || strings.HasPrefix(namespace, assemblyName+".") {
+		return true
+	}
+	suffix, isChild := strings.CutPrefix(assemblyName, namespace+".")
+	return isChild && !strings.Contains(suffix, ".")
+}
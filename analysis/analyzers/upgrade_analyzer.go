@@ -1,6 +1,8 @@
 package analyzers
 
 import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
 	"redun-pendancy/models"
 	"redun-pendancy/utils"
 	"sort"
@@ -9,29 +11,31 @@ import (
 
 type UpgradeAnalyzer struct {
 	results            *AnalysisResults
+	projectHandler     ProjectHandler
 	upgradeSuggestions map[*PackageInfo][]UpgradeSuggestion
+	dependencyCache    map[string]map[string]string //"Name/Version/Framework" => (PackageName => Version)
 }
 
 type UpgradeSuggestion struct {
-	Project        *PackageInfo
-	Dependency     *PackageInfo
-	SkippedPackage *PackageInfo
+	Project          *PackageInfo
+	Dependency       *PackageInfo
+	SkippedPackage   *PackageInfo
+	EffectivePackage *PackageInfo
 }
 
-func NewUpgradeAnalyzer(collector *AnalysisResults) *UpgradeAnalyzer {
+func NewUpgradeAnalyzer(collector *AnalysisResults, projectHandler ProjectHandler) *UpgradeAnalyzer {
 	return &UpgradeAnalyzer{
 		results:            collector,
+		projectHandler:     projectHandler,
 		upgradeSuggestions: make(map[*models.PackageInfo][]UpgradeSuggestion),
+		dependencyCache:    make(map[string]map[string]string),
 	}
 }
 
 func (analyzer *UpgradeAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
-	skippedPackages := analyzer.collectSkippedPackages(packages)
-
-	for _, skippedPackage := range skippedPackages {
-		seenPackages := utils.NewSet[*PackageInfo]()
-		seenPackages.Add(skippedPackage) //Might not be necesary (since it should be a "leaf node"), but just in case :)
-		analyzer.traverseUpForSuggestions(skippedPackage, skippedPackage, seenPackages)
+	for _, project := range projects {
+		resolution := analyzer.projectHandler.GetResolution(project)
+		analyzer.collectSuggestions(resolution)
 	}
 
 	for project, suggestedUpgrades := range analyzer.upgradeSuggestions {
@@ -39,31 +43,31 @@ func (analyzer *UpgradeAnalyzer) Analyze(projects []*PackageInfo, packages map[s
 	}
 }
 
-func (analyzer *UpgradeAnalyzer) collectSkippedPackages(packages map[string]*PackageInfo) []*PackageInfo {
-	var skippedPackages []*PackageInfo
-	for _, packageInfo := range packages {
-		if packageInfo.LoadStatus == models.LoadStatus_Skipped {
-			skippedPackages = append(skippedPackages, packageInfo)
-		}
-	}
-	return skippedPackages
-}
-
-func (analyzer *UpgradeAnalyzer) traverseUpForSuggestions(packageInfo *PackageInfo, skippedPackage *PackageInfo, seenPackages utils.Set[*PackageInfo]) {
-	for _, parent := range packageInfo.Parents {
-		if !seenPackages.Add(parent) {
+// Collects the direct dependencies that bring in package versions older than the ones effectively used by the project
+func (analyzer *UpgradeAnalyzer) collectSuggestions(resolution *models.ProjectResolution) {
+	project := resolution.Project
+	for _, eliminated := range resolution.Eliminated {
+		skippedPackage := eliminated.PackageInfo
+		effectivePackage := resolution.GetEffectivePackage(skippedPackage.Name)
+		if !utils.IsVersionHigher(effectivePackage.Version, skippedPackage.Version) {
+			//Not an older version (eg: a downgrade), nothing to upgrade here
 			continue
 		}
-		if parent.IsProject() {
-			suggestionList := analyzer.upgradeSuggestions[parent]
-			analyzer.upgradeSuggestions[parent] = append(suggestionList, UpgradeSuggestion{
-				Project:        parent,
-				Dependency:     packageInfo,
-				SkippedPackage: skippedPackage,
-			})
+
+		dependency := eliminated.GetDirectDependency()
+		if dependency == skippedPackage || dependency.IsProject() {
+			//Either the project references the older version directly, or it comes through a project reference
+			//(which gets its own suggestions)
 			continue
 		}
-		analyzer.traverseUpForSuggestions(parent, skippedPackage, seenPackages)
+
+		suggestionList := analyzer.upgradeSuggestions[project]
+		analyzer.upgradeSuggestions[project] = append(suggestionList, UpgradeSuggestion{
+			Project:          project,
+			Dependency:       dependency,
+			SkippedPackage:   skippedPackage,
+			EffectivePackage: effectivePackage,
+		})
 	}
 }
 
@@ -75,7 +79,86 @@ func (analyzer *UpgradeAnalyzer) addUpgradeSuggestions(project *PackageInfo, sug
 	for dependency, suggestions := range dependencyGroups {
 		suggestion := formatUpgradeSuggestion(dependency, suggestions)
 		analyzer.results.AddSuggestion(projectName, suggestion)
+
+		skippedPackages := utils.Map(suggestions, func(suggestion UpgradeSuggestion) *PackageInfo {
+			return suggestion.SkippedPackage
+		})
+		targetVersion := analyzer.findUpgradeVersion(project, dependency, suggestions)
+		if targetVersion != "" {
+			action := actions.NewUpgradePackageAction(projectName, dependency, targetVersion, skippedPackages)
+			analyzer.results.AddAction(action)
+		}
+	}
+}
+
+// Returns the lowest cached version of the dependency that no longer pulls in any of the skipped packages (or "" if none does)
+func (analyzer *UpgradeAnalyzer) findUpgradeVersion(project *PackageInfo, dependency *PackageInfo, suggestions []UpgradeSuggestion) string {
+	packageManager := analyzer.projectHandler.GetPackageManager()
+	for _, version := range packageManager.GetAvailableVersions(dependency.Name) {
+		if !utils.IsVersionHigher(version, dependency.Version) {
+			continue
+		}
+
+		_, err := analyzer.readDependencyVersions(dependency.Name, version, project.Framework)
+		if err != nil {
+			//Unreadable candidate, cannot tell what it pulls in
+			continue
+		}
+
+		closure := make(map[string]string)
+		analyzer.collectClosure(dependency.Name, version, project.Framework, closure)
+		if isFreeOfSkippedPackages(closure, suggestions) {
+			return version
+		}
+	}
+	return ""
+}
+
+// Collects the highest version of every package (transitively) required by the package version
+//
+// NOTE: Nested packages that cannot be read are ignored, as they only hide further (unknown) dependencies.
+func (analyzer *UpgradeAnalyzer) collectClosure(packageName string, version string, framework string, closure map[string]string) {
+	dependencyVersions, err := analyzer.readDependencyVersions(packageName, version, framework)
+	if err != nil {
+		return
+	}
+
+	for dependencyName, dependencyVersion := range dependencyVersions {
+		currentVersion, exists := closure[dependencyName]
+		if exists && !utils.IsVersionHigher(dependencyVersion, currentVersion) {
+			continue
+		}
+		closure[dependencyName] = dependencyVersion
+		analyzer.collectClosure(dependencyName, dependencyVersion, framework, closure)
+	}
+}
+
+func (analyzer *UpgradeAnalyzer) readDependencyVersions(packageName string, version string, framework string) (map[string]string, error) {
+	cacheKey := fmt.Sprintf("%s/%s/%s", strings.ToLower(packageName), version, framework)
+	dependencyVersions, exists := analyzer.dependencyCache[cacheKey]
+	if exists {
+		return dependencyVersions, nil
+	}
+
+	packageManager := analyzer.projectHandler.GetPackageManager()
+	dependencyVersions, err := packageManager.ReadDependencyVersions(packageName, version, framework)
+	if err != nil {
+		return nil, err
+	}
+	analyzer.dependencyCache[cacheKey] = dependencyVersions
+	return dependencyVersions, nil
+}
+
+// Checks that every skipped package is either gone or required with (at least) its effective version
+func isFreeOfSkippedPackages(closure map[string]string, suggestions []UpgradeSuggestion) bool {
+	for _, suggestion := range suggestions {
+		effectivePackage := suggestion.EffectivePackage
+		version, exists := closure[effectivePackage.Name]
+		if exists && utils.IsVersionHigher(effectivePackage.Version, version) {
+			return false
+		}
 	}
+	return true
 }
 
 func formatUpgradeSuggestion(dependency *PackageInfo, suggestions []UpgradeSuggestion) string {
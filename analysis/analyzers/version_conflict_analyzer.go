@@ -0,0 +1,127 @@
+package analyzers
+
+import (
+	"redun-pendancy/models"
+	"redun-pendancy/utils"
+	"sort"
+	"strings"
+)
+
+// VersionConflictAnalyzer finds packages required by several dependencies of a project with version ranges
+// that the effective version does not satisfy (aka: "diamond" conflicts)
+type VersionConflictAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+}
+
+type versionRequirement struct {
+	Range *models.VersionRange
+	Path  string //From the project down to the required package
+}
+
+func NewVersionConflictAnalyzer(collector *AnalysisResults, projectHandler ProjectHandler) *VersionConflictAnalyzer {
+	return &VersionConflictAnalyzer{
+		results:        collector,
+		projectHandler: projectHandler,
+	}
+}
+
+func (analyzer *VersionConflictAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	for _, project := range projects {
+		resolution := analyzer.projectHandler.GetResolution(project)
+		requirements := collectVersionRequirements(resolution)
+
+		packageNames := utils.GetMapKeys(requirements)
+		sort.Strings(packageNames)
+		for _, packageName := range packageNames {
+			packageRequirements := requirements[packageName]
+			effectivePackage := resolution.GetEffectivePackage(packageName)
+			if len(packageRequirements) <= 1 || effectivePackage == nil {
+				continue
+			}
+			if satisfiesRequirements(effectivePackage.Version, packageRequirements) {
+				continue
+			}
+			analyzer.addConflictSuggestion(project, effectivePackage, packageRequirements)
+		}
+	}
+}
+
+// Builds a map of "PackageName => []versionRequirement" with the ranges required by every effective package of the project
+func collectVersionRequirements(resolution *models.ProjectResolution) map[string][]versionRequirement {
+	requirements := make(map[string][]versionRequirement)
+	projectName := resolution.Project.Name
+	for _, resolvedPackage := range resolution.Resolved {
+		parent := resolvedPackage.PackageInfo
+		parentPath := projectName + "/" + resolvedPackage.FormatPath()
+		for _, dependency := range parent.Dependencies {
+			requirements[dependency.Name] = append(requirements[dependency.Name], versionRequirement{
+				Range: parent.GetDependencyRange(dependency),
+				Path:  parentPath + "/" + dependency.Name,
+			})
+		}
+	}
+
+	for _, packageRequirements := range requirements {
+		sort.Slice(packageRequirements, func(i, j int) bool {
+			return packageRequirements[i].Path < packageRequirements[j].Path
+		})
+	}
+	return requirements
+}
+
+func satisfiesRequirements(version string, requirements []versionRequirement) bool {
+	for _, requirement := range requirements {
+		if !requirement.Range.Satisfies(version) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the lowest known version (range bounds & NuGet cache) satisfying every requirement (or "" if none does)
+func (analyzer *VersionConflictAnalyzer) findMinimalPin(packageName string, requirements []versionRequirement) string {
+	candidates := analyzer.projectHandler.GetPackageManager().GetAvailableVersions(packageName)
+	for _, requirement := range requirements {
+		versionRange := requirement.Range
+		candidates = append(candidates, versionRange.MinVersion, versionRange.MaxVersion)
+	}
+
+	candidates = utils.Filter(candidates, func(candidate string) bool {
+		return candidate != "" && satisfiesRequirements(candidate, requirements)
+	})
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return utils.IsVersionHigher(candidates[j], candidates[i])
+	})
+	return candidates[0]
+}
+
+func (analyzer *VersionConflictAnalyzer) addConflictSuggestion(project *PackageInfo, effectivePackage *PackageInfo, requirements []versionRequirement) {
+	builder := strings.Builder{}
+	builder.WriteString("Package \"")
+	builder.WriteString(effectivePackage.Name)
+	builder.WriteString("\" resolves to ")
+	builder.WriteString(effectivePackage.Version)
+	builder.WriteString(", which does not satisfy every requirement:\n")
+	for _, requirement := range requirements {
+		builder.WriteString("- ")
+		builder.WriteString(requirement.Path)
+		builder.WriteString(" (")
+		builder.WriteString(requirement.Range.ToString())
+		builder.WriteString(")\n")
+	}
+
+	pinVersion := analyzer.findMinimalPin(effectivePackage.Name, requirements)
+	if pinVersion != "" {
+		builder.WriteString("Reference version \"")
+		builder.WriteString(pinVersion)
+		builder.WriteString("\" directly to satisfy all of them.\n")
+	} else {
+		builder.WriteString("No known version satisfies all of them.\n")
+	}
+	analyzer.results.AddSuggestion(project.Name, builder.String())
+}
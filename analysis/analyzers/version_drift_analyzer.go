@@ -0,0 +1,104 @@
+package analyzers
+
+import (
+	"fmt"
+	"redun-pendancy/analysis/actions"
+	"redun-pendancy/utils"
+	"sort"
+	"strings"
+)
+
+type VersionDriftAnalyzer struct {
+	results        *AnalysisResults
+	projectHandler ProjectHandler
+}
+
+type packageReference struct {
+	Project    *PackageInfo
+	Dependency *PackageInfo
+}
+
+func NewVersionDriftAnalyzer(collector *AnalysisResults, projectHandler ProjectHandler) *VersionDriftAnalyzer {
+	return &VersionDriftAnalyzer{
+		results:        collector,
+		projectHandler: projectHandler,
+	}
+}
+
+func (analyzer *VersionDriftAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	packageReferences := collectPackageReferences(projects)
+	packageNames := utils.GetMapKeys(packageReferences)
+	sort.Strings(packageNames)
+	for _, packageName := range packageNames {
+		references := packageReferences[packageName]
+		versionGroups := utils.GroupBy(references, func(reference packageReference) string {
+			return reference.Dependency.Version
+		})
+		if len(versionGroups) <= 1 {
+			//Every project uses the same version, next please
+			continue
+		}
+		analyzer.addDriftResults(packageName, references, versionGroups)
+	}
+}
+
+// Builds a map of "PackageName => []packageReference" with the direct package references of every project
+func collectPackageReferences(projects []*PackageInfo) map[string][]packageReference {
+	packageReferences := make(map[string][]packageReference)
+	for _, project := range projects {
+		for _, dependency := range project.Dependencies {
+			if !dependency.IsPackage() {
+				continue
+			}
+			packageReferences[dependency.Name] = append(packageReferences[dependency.Name], packageReference{
+				Project:    project,
+				Dependency: dependency,
+			})
+		}
+	}
+	return packageReferences
+}
+
+func (analyzer *VersionDriftAnalyzer) addDriftResults(packageName string, references []packageReference, versionGroups map[string][]packageReference) {
+	packageContainer := analyzer.projectHandler.GetPackageContainer()
+	targetVersion := packageContainer.GetHighestVersion(packageName)
+
+	suggestion := formatDriftSuggestion(packageName, versionGroups)
+	workspaceName := analyzer.projectHandler.GetWorkspaceName()
+	analyzer.results.AddSuggestion(workspaceName, suggestion)
+
+	sort.Slice(references, func(i, j int) bool {
+		return references[i].Project.Name < references[j].Project.Name
+	})
+	projectNames := utils.Map(references, func(reference packageReference) string {
+		return reference.Project.Name
+	})
+	dependencies := utils.Map(references, func(reference packageReference) *PackageInfo {
+		return reference.Dependency
+	})
+	action := actions.NewAlignVersionsAction(packageName, targetVersion, projectNames, dependencies)
+	analyzer.results.AddAction(action)
+}
+
+func formatDriftSuggestion(packageName string, versionGroups map[string][]packageReference) string {
+	versions := utils.GetMapKeys(versionGroups)
+	sort.Slice(versions, func(i, j int) bool {
+		return utils.IsVersionHigher(versions[i], versions[j])
+	})
+
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("Package \"%s\" is referenced with %d different versions:\n", packageName, len(versions)))
+	for _, version := range versions {
+		projectNames := utils.Map(versionGroups[version], func(reference packageReference) string {
+			return reference.Project.Name
+		})
+		sort.Strings(projectNames)
+
+		builder.WriteString("- ")
+		builder.WriteString(version)
+		builder.WriteString(": ")
+		builder.WriteString(strings.Join(projectNames, ", "))
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
@@ -0,0 +1,106 @@
+package analyzers
+
+import (
+	"fmt"
+	"log"
+	"redun-pendancy/analysis/advisories"
+	"redun-pendancy/utils"
+	"sort"
+	"strings"
+)
+
+// VulnerabilityAnalyzer matches the effective version of every package (direct & transitive) of each project against a local advisory database
+type VulnerabilityAnalyzer struct {
+	results             *AnalysisResults
+	projectHandler      ProjectHandler
+	workspaceName       string
+	advisoriesDirectory string
+}
+
+type packageVersionGroup struct {
+	Name     string
+	Version  string
+	Projects utils.Set[string] //Projects resolving to this package version
+}
+
+func NewVulnerabilityAnalyzer(results *AnalysisResults, projectHandler ProjectHandler, advisoriesDirectory string) *VulnerabilityAnalyzer {
+	return &VulnerabilityAnalyzer{
+		results:             results,
+		projectHandler:      projectHandler,
+		workspaceName:       projectHandler.GetWorkspaceName(),
+		advisoriesDirectory: advisoriesDirectory,
+	}
+}
+
+func (analyzer *VulnerabilityAnalyzer) Analyze(projects []*PackageInfo, packages map[string]*PackageInfo) {
+	if analyzer.advisoriesDirectory == "" {
+		//Not configured
+		return
+	}
+
+	database, err := advisories.LoadDatabase(analyzer.advisoriesDirectory)
+	if err != nil {
+		log.Printf(`[Warning] Failed to load advisories from "%s": %v`, analyzer.advisoriesDirectory, err)
+		suggestion := fmt.Sprintf(`Vulnerability analysis skipped, failed to load advisories from "%s"`, analyzer.advisoriesDirectory)
+		analyzer.results.AddSuggestion(analyzer.workspaceName, suggestion)
+		return
+	}
+
+	for _, usedPackage := range analyzer.collectUsedPackages(projects) {
+		matchedAdvisories := database.FindAdvisories(usedPackage.Name, usedPackage.Version)
+		for _, advisory := range matchedAdvisories {
+			analyzer.addVulnerabilitySuggestion(usedPackage, advisory)
+		}
+	}
+}
+
+// Groups the effective package versions of every project, sorted by name & version
+func (analyzer *VulnerabilityAnalyzer) collectUsedPackages(projects []*PackageInfo) []*packageVersionGroup {
+	usedPackages := make(map[string]*packageVersionGroup)
+	for _, project := range projects {
+		resolution := analyzer.projectHandler.GetResolution(project)
+		for _, resolvedPackage := range resolution.Resolved {
+			packageInfo := resolvedPackage.PackageInfo
+			if !packageInfo.IsPackage() {
+				continue
+			}
+
+			key := packageInfo.Name + "@" + packageInfo.Version
+			usedPackage, exists := usedPackages[key]
+			if !exists {
+				usedPackage = &packageVersionGroup{
+					Name:     packageInfo.Name,
+					Version:  packageInfo.Version,
+					Projects: utils.NewSet[string](),
+				}
+				usedPackages[key] = usedPackage
+			}
+			usedPackage.Projects.Add(project.Name)
+		}
+	}
+
+	keys := utils.GetMapKeys(usedPackages)
+	sort.Strings(keys)
+	return utils.Map(keys, func(key string) *packageVersionGroup {
+		return usedPackages[key]
+	})
+}
+
+func (analyzer *VulnerabilityAnalyzer) addVulnerabilitySuggestion(usedPackage *packageVersionGroup, advisory *advisories.Advisory) {
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("Package \"%s\" (%s) is affected by %s [%s]: %s\n",
+		usedPackage.Name, usedPackage.Version, advisory.Id, advisory.Severity, advisory.Summary,
+	))
+
+	fixedVersion := advisory.GetLowestFixedVersion(usedPackage.Version)
+	builder.WriteString("- Lowest fixed version: ")
+	builder.WriteString(utils.ValueOrDefault(fixedVersion, "None"))
+	builder.WriteString("\n")
+
+	affectedProjects := utils.GetMapKeys(usedPackage.Projects)
+	sort.Strings(affectedProjects)
+	builder.WriteString("- Affected projects: ")
+	builder.WriteString(strings.Join(affectedProjects, ", "))
+	builder.WriteString("\n")
+	analyzer.results.AddSuggestion(analyzer.workspaceName, builder.String())
+}
@@ -29,17 +29,26 @@ func (ancestryMap *AncestryMap) processAncestors(project *PackageInfo) utils.Set
 	}
 
 	projectAncestors = utils.NewSet[*PackageInfo]()
+	ancestryMap.collectAncestors(project, projectAncestors)
+	projectAncestors.Remove(project) //Circular dependencies would make the project its own ancestor
+	ancestryMap.ancestors[project] = projectAncestors
+	return projectAncestors
+}
+
+// NOTE: Only fully processed projects are stored in the map, so reusing their ancestors is safe (even with circular dependencies)
+func (ancestryMap *AncestryMap) collectAncestors(project *PackageInfo, projectAncestors utils.Set[*PackageInfo]) {
 	for _, parent := range project.Dependencies {
-		if !parent.IsProject() {
+		if !parent.IsProject() || !projectAncestors.Add(parent) {
 			continue
 		}
 
-		projectAncestors.Add(parent)
-		parentAncestors := ancestryMap.processAncestors(parent)
-		projectAncestors.UnionWith(parentAncestors)
+		parentAncestors, exists := ancestryMap.ancestors[parent]
+		if exists {
+			projectAncestors.UnionWith(parentAncestors)
+			continue
+		}
+		ancestryMap.collectAncestors(parent, projectAncestors)
 	}
-	ancestryMap.ancestors[project] = projectAncestors
-	return projectAncestors
 }
 
 func (ancestryMap *AncestryMap) GetAncestors(project *PackageInfo) utils.Set[*PackageInfo] {
@@ -0,0 +1,55 @@
+package analysis
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+)
+
+// LicenseInventoryEntry is a package found in a project's closure along with its license
+type LicenseInventoryEntry struct {
+	Project string
+	Package string
+	Version string
+	License string
+	Path    string //Dependency path from the project down to the package
+}
+
+func BuildLicenseInventory(projectHandler ProjectHandler) []*LicenseInventoryEntry {
+	inventory := []*LicenseInventoryEntry{}
+	for _, project := range projectHandler.GetProjects() {
+		resolution := projectHandler.GetResolution(project)
+		for _, resolvedPackage := range resolution.Resolved {
+			packageInfo := resolvedPackage.PackageInfo
+			if packageInfo.IsProject() {
+				continue
+			}
+
+			inventory = append(inventory, &LicenseInventoryEntry{
+				Project: project.Name,
+				Package: packageInfo.Name,
+				Version: packageInfo.Version,
+				License: packageInfo.GetLicense(),
+				Path:    project.Name + "/" + resolvedPackage.FormatPath(),
+			})
+		}
+	}
+
+	sort.Slice(inventory, func(i, j int) bool {
+		if inventory[i].Project != inventory[j].Project {
+			return inventory[i].Project < inventory[j].Project
+		}
+		return inventory[i].Package < inventory[j].Package
+	})
+	return inventory
+}
+
+func WriteLicenseInventory(writer io.Writer, inventory []*LicenseInventoryEntry) error {
+	csvWriter := csv.NewWriter(writer)
+	csvWriter.Write([]string{"Project", "Package", "Version", "License", "Path"})
+	for _, entry := range inventory {
+		csvWriter.Write([]string{entry.Project, entry.Package, entry.Version, entry.License, entry.Path})
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
@@ -2,8 +2,10 @@ package analysis
 
 import (
 	"redun-pendancy/analysis/actions"
+	"redun-pendancy/handlers/base"
 	"redun-pendancy/models"
 )
 
 type PackageInfo = models.PackageInfo
 type ProjectAction = actions.ProjectAction
+type ProjectHandler = base.ProjectHandler
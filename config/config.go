@@ -0,0 +1,146 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"redun-pendancy/utils"
+)
+
+// Optional file (placed next to the project collection file) used to configure the analyzers
+const ConfigFileName = "redun-pendancy.json"
+
+type Config struct {
+	CentralPackages CentralPackagesConfig `json:"centralPackages"`
+	Advisories      AdvisoriesConfig      `json:"advisories"`
+	Licenses        LicensesConfig        `json:"licenses"`
+	Layering        LayeringConfig        `json:"layering"`
+	BannedPackages  []BannedPackage       `json:"bannedPackages"`
+	Prerelease      PrereleaseConfig      `json:"prerelease"`
+	UnusedPackages  UnusedPackagesConfig  `json:"unusedPackages"`
+}
+
+type CentralPackagesConfig struct {
+	VersionPolicy VersionPolicy `json:"versionPolicy"` //Picks the central version of packages referenced with different versions
+}
+
+type AdvisoriesConfig struct {
+	Directory string `json:"directory"` //Local advisory database (relative to the config file). Empty => Vulnerability analysis disabled
+}
+
+// NOTE: Entries are SPDX license identifiers (eg: "MIT") or license URLs, matched case-insensitively.
+type LicensesConfig struct {
+	Allowed []string `json:"allowed"` //Empty => Every license not denied is allowed
+	Denied  []string `json:"denied"`
+}
+
+type LayeringConfig struct {
+	Rules []LayeringRule `json:"rules"`
+}
+
+// NOTE: Patterns are globs (eg: "*.Domain") matched case-insensitively against project names (without extension) and package names.
+type LayeringRule struct {
+	From      string   `json:"from"`      //Projects the rule applies to
+	Except    []string `json:"except"`    //Projects excluded from the rule
+	Forbidden string   `json:"forbidden"` //Projects/Packages that must not be referenced (directly or transitively)
+}
+
+func (rule *LayeringRule) validate() error {
+	patterns := append([]string{rule.From, rule.Forbidden}, rule.Except...)
+	for _, pattern := range patterns {
+		if pattern == "" || !utils.IsValidNamePattern(pattern) {
+			return fmt.Errorf(`invalid "layering.rules" pattern "%s"`, pattern)
+		}
+	}
+	return nil
+}
+
+// NOTE: Package patterns are globs (eg: "Microsoft.Azure.*") matched case-insensitively.
+type BannedPackage struct {
+	Package     string `json:"package"`
+	Replacement string `json:"replacement"` //Optional. A trailing "*" keeps the suffix matched by the package pattern (eg: "Microsoft.Azure.*" => "Azure.*")
+	Version     string `json:"version"`     //Version of the replacement package. Empty => No "replace package" action
+	Reason      string `json:"reason"`      //Optional
+}
+
+type PrereleaseConfig struct {
+	Allowed []string `json:"allowed"` //Globs over the package names allowed to use prerelease versions (eg: "Microsoft.Extensions.*")
+}
+
+type UnusedPackagesConfig struct {
+	Enabled bool     `json:"enabled"` //Scans the source files of every project, which might be slow on big solutions
+	Ignored []string `json:"ignored"` //Globs over the package names never reported (eg: packages only used through reflection)
+}
+
+func NewDefaultConfig() *Config {
+	return &Config{
+		CentralPackages: CentralPackagesConfig{
+			VersionPolicy: VersionPolicy_Highest,
+		},
+	}
+}
+
+// Loads the config file from the given folder. Returns the default config if the file does not exist
+func Load(folderPath string) (*Config, error) {
+	config := NewDefaultConfig()
+	configFilePath := filepath.Join(folderPath, ConfigFileName)
+	data, err := os.ReadFile(configFilePath)
+	if os.IsNotExist(err) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Println("Reading:", configFilePath)
+	err = json.Unmarshal(data, config)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to parse "%s": %w`, configFilePath, err)
+	}
+
+	err = config.validate()
+	if err != nil {
+		return nil, fmt.Errorf(`invalid "%s": %w`, configFilePath, err)
+	}
+
+	advisoriesDirectory := config.Advisories.Directory
+	if advisoriesDirectory != "" && !filepath.IsAbs(advisoriesDirectory) {
+		config.Advisories.Directory = filepath.Join(folderPath, advisoriesDirectory)
+	}
+	return config, nil
+}
+
+func (config *Config) validate() error {
+	versionPolicy := config.CentralPackages.VersionPolicy
+	if !versionPolicy.IsValid() {
+		return fmt.Errorf(`unknown "centralPackages.versionPolicy" value "%s"`, versionPolicy)
+	}
+
+	for _, rule := range config.Layering.Rules {
+		err := rule.validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, bannedPackage := range config.BannedPackages {
+		if bannedPackage.Package == "" || !utils.IsValidNamePattern(bannedPackage.Package) {
+			return fmt.Errorf(`invalid "bannedPackages" pattern "%s"`, bannedPackage.Package)
+		}
+	}
+
+	for _, pattern := range config.Prerelease.Allowed {
+		if !utils.IsValidNamePattern(pattern) {
+			return fmt.Errorf(`invalid "prerelease.allowed" pattern "%s"`, pattern)
+		}
+	}
+
+	for _, pattern := range config.UnusedPackages.Ignored {
+		if !utils.IsValidNamePattern(pattern) {
+			return fmt.Errorf(`invalid "unusedPackages.ignored" pattern "%s"`, pattern)
+		}
+	}
+	return nil
+}
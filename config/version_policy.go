@@ -0,0 +1,18 @@
+package config
+
+// VersionPolicy decides which version to keep when a package is referenced with different versions
+type VersionPolicy string
+
+const (
+	VersionPolicy_Highest  VersionPolicy = "highest"
+	VersionPolicy_Lowest   VersionPolicy = "lowest"
+	VersionPolicy_MostUsed VersionPolicy = "mostUsed" //Ties are broken by picking the highest version
+)
+
+func (versionPolicy VersionPolicy) IsValid() bool {
+	switch versionPolicy {
+	case VersionPolicy_Highest, VersionPolicy_Lowest, VersionPolicy_MostUsed:
+		return true
+	}
+	return false
+}
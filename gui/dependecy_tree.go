@@ -1,6 +1,7 @@
 package gui
 
 import (
+	"fmt"
 	"redun-pendancy/helpers"
 	"redun-pendancy/models"
 	"redun-pendancy/utils"
@@ -13,11 +14,15 @@ import (
 
 type TreeNode struct {
 	PackageInfo *PackageInfo
+	RootProject *PackageInfo
 	ChildPaths  []string
+	InCycle     bool //The reference from the parent project is part of a circular dependency
 }
 
 type DependencyTree struct {
 	widget            *widget.Tree
+	projectHandler    ProjectHandler
+	cycles            []*DependencyCycle
 	nodes             map[string]TreeNode //nodePath => TreeNode
 	filteredPaths     map[string][]string //nodePath => childPaths (filtered)
 	selectionCallback func(*PackageInfo)
@@ -48,9 +53,11 @@ func (dt *DependencyTree) SetSelectionCallback(callback func(*PackageInfo)) {
 func (dt *DependencyTree) Load(projectHandler ProjectHandler) {
 	tracker := helpers.NewCircularDependencyTracker()
 	projects := projectHandler.GetProjects()
+	dt.projectHandler = projectHandler
+	dt.cycles = helpers.FindDependencyCycles(projects)
 	dt.nodes[""] = TreeNode{
 		PackageInfo: nil, //Root node has no PackageInfo
-		ChildPaths:  dt.buildPackagePaths("", projects, tracker),
+		ChildPaths:  dt.buildPackagePaths("", nil, nil, projects, tracker),
 	}
 }
 
@@ -130,23 +137,29 @@ func (dt *DependencyTree) SetFilter(nodePaths []string) {
 	dt.filteredPaths = filteredPaths
 }
 
-// NOTE: Also populates the nodes in the dependency tree
-func (dt *DependencyTree) buildPackagePaths(parentPath string, packages []*PackageInfo, tracker *helpers.CircularDependencyTracker) []string {
+// NOTE: Also populates the nodes in the dependency tree. Circular references are added without children.
+func (dt *DependencyTree) buildPackagePaths(parentPath string, parentPackage *PackageInfo, rootProject *PackageInfo, packages []*PackageInfo, tracker *helpers.CircularDependencyTracker) []string {
 	packagePaths := make([]string, 0, len(packages))
 	for _, packageInfo := range packages {
 		isProject := packageInfo.IsProject()
-		if isProject && !tracker.RegisterProject(packageInfo.Name, parentPath) {
-			continue
-		}
+		isCircular := isProject && !tracker.RegisterProject(packageInfo.Name)
 
+		var childPaths []string
+		nodeRootProject := utils.TernarySelect(rootProject == nil, packageInfo, rootProject)
 		packagePath := parentPath + "/" + packageInfo.Name
+		if !isCircular {
+			childPaths = dt.buildPackagePaths(packagePath, packageInfo, nodeRootProject, packageInfo.Dependencies, tracker)
+		}
+
 		dt.nodes[packagePath] = TreeNode{
 			PackageInfo: packageInfo,
-			ChildPaths:  dt.buildPackagePaths(packagePath, packageInfo.Dependencies, tracker),
+			RootProject: nodeRootProject,
+			ChildPaths:  childPaths,
+			InCycle:     dt.isCycleEdge(parentPackage, packageInfo),
 		}
 		packagePaths = append(packagePaths, packagePath)
 
-		if isProject {
+		if isProject && !isCircular {
 			tracker.RemoveProject(packageInfo.Name)
 		}
 	}
@@ -154,6 +167,19 @@ func (dt *DependencyTree) buildPackagePaths(parentPath string, packages []*Packa
 	return packagePaths
 }
 
+func (dt *DependencyTree) isCycleEdge(parentPackage *PackageInfo, packageInfo *PackageInfo) bool {
+	if parentPackage == nil || !packageInfo.IsProject() {
+		return false
+	}
+
+	for _, cycle := range dt.cycles {
+		if cycle.ContainsEdge(parentPackage, packageInfo) {
+			return true
+		}
+	}
+	return false
+}
+
 // =================================
 // Implementations for "widget.Tree"
 // =================================
@@ -174,31 +200,74 @@ func (dt *DependencyTree) createNode(isBranch bool) fyne.CanvasObject {
 }
 
 func (dt *DependencyTree) updateNode(nodePath string, branch bool, node fyne.CanvasObject) {
-	packageInfo := dt.nodes[nodePath].PackageInfo
+	treeNode := dt.nodes[nodePath]
+	packageInfo := treeNode.PackageInfo
 	if packageInfo != nil {
 		label := node.(*widget.Label)
-		if packageInfo.LoadStatus == models.LoadStatus_Skipped {
-			updateSkippedNode(label, packageInfo)
+		if treeNode.InCycle {
+			updateCircularNode(label, packageInfo)
+			return
+		}
+		if packageInfo.LoadStatus == models.LoadStatus_Unresolved {
+			updateUnresolvedNode(label, packageInfo)
+			return
+		}
+
+		effectivePackage := dt.getEffectivePackage(treeNode)
+		if effectivePackage != packageInfo {
+			updateSkippedNode(label, packageInfo, effectivePackage)
 			return
 		}
 		updateNormalNode(label, packageInfo)
 	}
 }
 
+// Returns the package version that the node's root project effectively uses
+func (dt *DependencyTree) getEffectivePackage(treeNode TreeNode) *PackageInfo {
+	packageInfo := treeNode.PackageInfo
+	if packageInfo.IsProject() || treeNode.RootProject == nil {
+		return packageInfo
+	}
+
+	resolution := dt.projectHandler.GetResolution(treeNode.RootProject)
+	effectivePackage := resolution.GetEffectivePackage(packageInfo.Name)
+	if effectivePackage == nil {
+		//Technically shouldn't happen...
+		return packageInfo
+	}
+	return effectivePackage
+}
+
 func updateNormalNode(label *widget.Label, packageInfo *PackageInfo) {
 	label.SetText(packageInfo.ToString())
 	label.Importance = widget.MediumImportance
 	label.TextStyle = fyne.TextStyle{}
 }
 
-func updateSkippedNode(label *widget.Label, packageInfo *PackageInfo) {
-	label.SetText("(~) " + packageInfo.ToString())
+func updateSkippedNode(label *widget.Label, packageInfo *PackageInfo, effectivePackage *PackageInfo) {
+	label.SetText(fmt.Sprintf("(~) %s => %s", packageInfo.ToString(), effectivePackage.Version))
 	label.Importance = widget.WarningImportance
 	label.TextStyle = fyne.TextStyle{
 		Italic: true,
 	}
 }
 
+func updateCircularNode(label *widget.Label, packageInfo *PackageInfo) {
+	label.SetText("(!) " + packageInfo.ToString())
+	label.Importance = widget.DangerImportance
+	label.TextStyle = fyne.TextStyle{
+		Bold: true,
+	}
+}
+
+func updateUnresolvedNode(label *widget.Label, packageInfo *PackageInfo) {
+	label.SetText("(?) " + packageInfo.ToString())
+	label.Importance = widget.DangerImportance
+	label.TextStyle = fyne.TextStyle{
+		Italic: true,
+	}
+}
+
 func (dt *DependencyTree) onNodeSelected(nodePath string) {
 	if dt.selectionCallback != nil {
 		packageInfo := dt.nodes[nodePath].PackageInfo
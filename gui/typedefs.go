@@ -7,3 +7,4 @@ import (
 
 type PackageInfo = models.PackageInfo
 type ProjectHandler = base.ProjectHandler
+type DependencyCycle = models.DependencyCycle
@@ -10,6 +10,7 @@ import (
 type PackageContainer struct {
 	packages        map[string]*PackageInfo
 	highestVersions map[string]string //PackageName => Version
+	diagnostics     []*Diagnostic
 }
 
 func NewPackageContainer() *PackageContainer {
@@ -23,6 +24,16 @@ func (packageContainer *PackageContainer) GetPackages() map[string]*PackageInfo
 	return packageContainer.packages
 }
 
+func (packageContainer *PackageContainer) GetDiagnostics() []*Diagnostic {
+	return packageContainer.diagnostics
+}
+
+func (packageContainer *PackageContainer) AddDiagnostic(packageInfo *PackageInfo, message string) {
+	diagnostic := models.NewDiagnostic(packageInfo, message)
+	log.Println("[Warning]", diagnostic.ToString())
+	packageContainer.diagnostics = append(packageContainer.diagnostics, diagnostic)
+}
+
 func (packageContainer *PackageContainer) HasPackage(name string, version string, framework string) bool {
 	key := buildPackageKey(name, version, framework)
 	_, exists := packageContainer.packages[key]
@@ -45,15 +56,13 @@ func (packageContainer *PackageContainer) GetOrCreatePackage(name string, versio
 	return packageInfo
 }
 
-func (packageContainer *PackageContainer) Load(packageInfo *PackageInfo, packageManager PackageManager, rootFramework string) error {
-	if packageInfo.LoadStatus != models.LoadStatus_None {
-		return nil
-	}
-
-	if packageContainer.shouldSkipPackage(packageInfo) {
-		log.Println("Skipping:", packageInfo.ToString())
-		packageInfo.MarkAsSkipped()
-		return nil
+// Fetches the dependencies of the package (only once). Dependencies are NOT loaded recursively.
+//
+// NOTE: Packages whose dependencies can't be fetched are marked as "unresolved" and reported as diagnostics,
+// so that the rest of the graph still gets loaded.
+func (packageContainer *PackageContainer) Load(packageInfo *PackageInfo, packageManager PackageManager, rootFramework string) {
+	if packageInfo.LoadStatus == models.LoadStatus_Loaded || packageInfo.IsUnresolved() {
+		return
 	}
 
 	log.Println("Loading:", packageInfo.ToString())
@@ -61,7 +70,9 @@ func (packageContainer *PackageContainer) Load(packageInfo *PackageInfo, package
 	if !packageInfo.IsProject() {
 		err := packageManager.FetchDependencies(packageInfo, rootFramework)
 		if err != nil {
-			return err
+			packageInfo.MarkAsUnresolved()
+			packageContainer.AddDiagnostic(packageInfo, err.Error())
+			return
 		}
 	}
 
@@ -69,29 +80,19 @@ func (packageContainer *PackageContainer) Load(packageInfo *PackageInfo, package
 		fmt.Printf("- %s %s\n", dependency.Name, dependency.Version)
 	}
 	fmt.Println()
+}
 
-	for _, dependency := range packageInfo.Dependencies {
-		err := packageContainer.Load(dependency, packageManager, rootFramework)
-		if err != nil {
-			return err
+// Marks every package that was never loaded (ie: not effective in any project) as "skipped"
+func (packageContainer *PackageContainer) MarkUnloadedAsSkipped() {
+	for _, packageInfo := range packageContainer.packages {
+		if packageInfo.LoadStatus == models.LoadStatus_None {
+			packageInfo.MarkAsSkipped()
 		}
 	}
-	return nil
 }
 
-func (packageContainer *PackageContainer) shouldSkipPackage(packageInfo *PackageInfo) bool {
-	key := packageInfo.Name
-	highestVersion, exists := packageContainer.highestVersions[key]
-	if !exists {
-		//Technically this should never happen (unless the package is created from the outside)
-		return false
-	}
-
-	version := packageInfo.Version
-	if version == highestVersion || utils.IsVersionHigher(version, highestVersion) {
-		return false
-	}
-	return true
+func (packageContainer *PackageContainer) GetHighestVersion(packageName string) string {
+	return packageContainer.highestVersions[packageName]
 }
 
 func buildPackageKey(name string, version string, framework string) string {
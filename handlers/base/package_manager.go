@@ -2,4 +2,13 @@ package base
 
 type PackageManager interface {
 	FetchDependencies(packageInfo *PackageInfo, rootFramework string) error
+	GetAvailableVersions(packageName string) []string
+	ReadDependencyVersions(packageName string, version string, rootFramework string) (map[string]string, error)
+	GetSupportedFrameworks(packageName string, version string) []string
+	GetAssemblyNames(packageName string, version string) []string
+	CheckFrameworkCompatibility(framework string, targetFramework string) FrameworkCompatibility
+	FindSharedFramework(project *PackageInfo, dependency *PackageInfo) string
+	GetFrameworkEndOfSupport(framework string) string
+	GetLatestLtsFramework(framework string) string
+	FindIncompatiblePackages(project *PackageInfo, framework string) []*PackageInfo
 }
@@ -5,17 +5,38 @@ type ProjectHandler interface {
 
 	GetWorkspaceName() string
 	GetPackageContainer() *PackageContainer
+	GetPackageManager() PackageManager
+	GetDiagnostics() []*Diagnostic
 
 	GetProjects() []*PackageInfo
+	RemoveProject(projectName string) error
+	AddProjectToSolution(projectName string) error
+	GetResolution(project *PackageInfo) *ProjectResolution
+	GetSourceNamespaces(project *PackageInfo) ([]string, error)
+	GetProjectReferences(projectName string) []*ProjectReference
+	UpdateProjectReferencePath(projectName string, referenceName string, newPath string) error
+	RetargetProject(projectName string, framework string) error
 
 	AddDependency(projectName string, dependency *PackageInfo)
 	RemoveDependency(projectName string, dependency *PackageInfo)
+	GetDeclaredVersion(projectName string, packageName string) string
+	GetLocalVersion(projectName string, packageName string) string
+	StripLocalVersion(projectName string, packageName string) error
+	ConvertToVersionOverride(projectName string, packageName string) error
+	GetVersionOverride(projectName string, packageName string) string
+	RemoveVersionOverride(projectName string, packageName string) error
+	UpdateDependencyVersion(projectName string, dependency *PackageInfo, version string) bool
+	ReplaceDependency(projectName string, dependency *PackageInfo, replacementName string, version string) error
 
 	DividesProjectsAndPackages(projectName string) bool
 	SortDependencies(projectName string, dependencies []*PackageInfo)
 
-	GetGlobalPackages() map[string]string //PackageName => Version
+	GetGlobalPackages() map[string]string   //PackageName => Version
+	GetGlobalReferences() map[string]string //PackageName => Version ("GlobalPackageReference")
+	UsesCentralPackageManagement() bool
+	AddGlobalPackage(packageName string, version string) error
 	RemoveGlobalPackage(packageName string) bool
+	CentralizePackageVersions(packageVersions map[string]string) error
 
 	CommitChanges() error
 	RevertChanges()
@@ -3,3 +3,7 @@ package base
 import "redun-pendancy/models"
 
 type PackageInfo = models.PackageInfo
+type Diagnostic = models.Diagnostic
+type ProjectResolution = models.ProjectResolution
+type FrameworkCompatibility = models.FrameworkCompatibility
+type ProjectReference = models.ProjectReference
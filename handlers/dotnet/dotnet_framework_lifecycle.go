@@ -0,0 +1,91 @@
+package dotnet
+
+import (
+	"redun-pendancy/utils"
+)
+
+// frameworkLifecycle is the end of support of a target framework version (as published by Microsoft's support lifecycle)
+type frameworkLifecycle struct {
+	Framework    string
+	EndOfSupport string //Format: "YYYY-MM-DD"
+	IsLts        bool   //Long Term Support release, used as the retarget suggestion of its family
+}
+
+// Support lifecycle of .NET (Core) & .NET Framework versions. Versions without an end of support date follow the lifecycle of the OS (eg: .NET Framework 4.6.2+)
+var frameworkLifecycles = []frameworkLifecycle{
+	{"netcoreapp1.0", "2019-06-27", true},
+	{"netcoreapp1.1", "2019-06-27", false},
+	{"netcoreapp2.0", "2018-10-01", false},
+	{"netcoreapp2.1", "2021-08-21", true},
+	{"netcoreapp2.2", "2019-12-23", false},
+	{"netcoreapp3.0", "2020-03-03", false},
+	{"netcoreapp3.1", "2022-12-13", true},
+	{"net5.0", "2022-05-10", false},
+	{"net6.0", "2024-11-12", true},
+	{"net7.0", "2024-05-14", false},
+	{"net8.0", "2026-11-10", true},
+	{"net9.0", "2026-11-10", false},
+	{"net10.0", "2028-11-14", true},
+	{"net40", "2016-01-12", false},
+	{"net45", "2016-01-12", false},
+	{"net451", "2016-01-12", false},
+	{"net452", "2022-04-26", false},
+	{"net46", "2022-04-26", false},
+	{"net461", "2022-04-26", false},
+	{"net462", "", false},
+	{"net47", "", false},
+	{"net471", "", false},
+	{"net472", "", false},
+	{"net48", "", true},
+	{"net481", "", false},
+}
+
+func findFrameworkLifecycle(framework string) (*frameworkLifecycle, bool) {
+	targetFramework, parsed := parseTargetFramework(framework)
+	if !parsed {
+		return nil, false
+	}
+
+	for index := range frameworkLifecycles {
+		lifecycle := &frameworkLifecycles[index]
+		lifecycleFramework, _ := parseTargetFramework(lifecycle.Framework)
+		if isSameFrameworkVersion(targetFramework, lifecycleFramework) {
+			return lifecycle, true
+		}
+	}
+	return nil, false
+}
+
+func isSameFrameworkVersion(left *DotNetTargetFramework, right *DotNetTargetFramework) bool {
+	if left.Family != right.Family {
+		return false
+	}
+	result, err := utils.CompareVersions(left.Version, right.Version)
+	return err == nil && result == 0
+}
+
+// Returns the end of support date ("YYYY-MM-DD") of the framework, or "" if unknown or not scheduled
+func getFrameworkEndOfSupport(framework string) string {
+	lifecycle, exists := findFrameworkLifecycle(framework)
+	if !exists {
+		return ""
+	}
+	return lifecycle.EndOfSupport
+}
+
+// Returns the latest Long Term Support framework of the same family (eg: "net10.0" for "netcoreapp3.1"), or "" if unknown
+func getLatestLtsFramework(framework string) string {
+	targetFramework, parsed := parseTargetFramework(framework)
+	if !parsed {
+		return ""
+	}
+
+	latestFramework := ""
+	for _, lifecycle := range frameworkLifecycles {
+		lifecycleFramework, _ := parseTargetFramework(lifecycle.Framework)
+		if lifecycle.IsLts && lifecycleFramework.Family == targetFramework.Family {
+			latestFramework = lifecycle.Framework //Entries are sorted by version
+		}
+	}
+	return latestFramework
+}
@@ -1,11 +1,12 @@
 package dotnet
 
 import (
+	"bytes"
 	"log"
-	"os"
 	"path/filepath"
 	"redun-pendancy/helpers"
 	"redun-pendancy/utils"
+	"slices"
 
 	"github.com/beevik/etree"
 )
@@ -18,16 +19,53 @@ type DotNetProjectFile struct {
 	projectRefNodes     *helpers.OrderedMap[string, *etree.Element] //ProjectName => Node
 	addedDependencies   []*PackageInfo
 	removedDependencies []*PackageInfo
+	versionUpdates      []dependencyVersionUpdate
+	strippedVersions    []strippedPackageVersion
+	removedOverrides    []removedVersionOverride
+	pathUpdates         []projectReferencePathUpdate
+	targetFrameworkNode *etree.Element
+	originalFramework   string //Framework before the first retarget ("" if not retargeted)
 	isDirty             bool
 }
 
-func NewDotNetProjectFile(project *PackageInfo, xmlFile *helpers.XMLFileHelper) *DotNetProjectFile {
+type strippedPackageVersion struct {
+	node          *etree.Element
+	version       string
+	versionNode   *etree.Element //Only set when the version was a "<Version>" child element
+	versionIndex  int
+	blankNodes    []etree.Token //Whitespace left without any sibling element (removed to make the element self-closing)
+	isOverride    bool          //The version was turned into a "VersionOverride" attribute
+	oldDependency *PackageInfo
+	newDependency *PackageInfo
+}
+
+type removedVersionOverride struct {
+	node          *etree.Element
+	version       string
+	oldDependency *PackageInfo
+	newDependency *PackageInfo
+}
+
+type projectReferencePathUpdate struct {
+	node    *etree.Element
+	oldPath string
+}
+
+type dependencyVersionUpdate struct {
+	OldDependency *PackageInfo
+	NewDependency *PackageInfo
+	OldVersion    string //Version (or "VersionOverride") as written before the update (eg: "1.*" or "" if missing)
+	IsCentral     bool   //The version lives in "Directory.Packages.props" (the project file is left untouched)
+}
+
+func NewDotNetProjectFile(project *PackageInfo, xmlFile *helpers.XMLFileHelper, targetFrameworkNode *etree.Element) *DotNetProjectFile {
 	return &DotNetProjectFile{
-		project:         project,
-		xmlFile:         xmlFile,
-		folderPath:      filepath.Dir(project.FilePath),
-		packageRefNodes: helpers.NewOrderedMap[string, *etree.Element](),
-		projectRefNodes: helpers.NewOrderedMap[string, *etree.Element](),
+		project:             project,
+		xmlFile:             xmlFile,
+		folderPath:          filepath.Dir(project.FilePath),
+		packageRefNodes:     helpers.NewOrderedMap[string, *etree.Element](),
+		projectRefNodes:     helpers.NewOrderedMap[string, *etree.Element](),
+		targetFrameworkNode: targetFrameworkNode,
 	}
 }
 
@@ -35,6 +73,10 @@ func (projectFile *DotNetProjectFile) GetProject() *PackageInfo {
 	return projectFile.project
 }
 
+func (projectFile *DotNetProjectFile) GetFolderPath() string {
+	return projectFile.folderPath
+}
+
 func (projectFile *DotNetProjectFile) HasChanges() bool {
 	return projectFile.isDirty
 }
@@ -192,20 +234,299 @@ func (projectFile *DotNetProjectFile) RemoveDependency(dependency *PackageInfo)
 	return true
 }
 
+// Replaces the dependency with "newDependency" (same package, different version), editing the "Version" in place
+func (projectFile *DotNetProjectFile) UpdateDependencyVersion(dependency *PackageInfo, newDependency *PackageInfo) bool {
+	return projectFile.updateDependencyVersion(dependency, newDependency, false)
+}
+
+// Replaces the dependency with "newDependency" after its central version (in "Directory.Packages.props") got updated
+func (projectFile *DotNetProjectFile) UpdateCentralDependencyVersion(dependency *PackageInfo, newDependency *PackageInfo) bool {
+	return projectFile.updateDependencyVersion(dependency, newDependency, true)
+}
+
+func (projectFile *DotNetProjectFile) updateDependencyVersion(dependency *PackageInfo, newDependency *PackageInfo, isCentral bool) bool {
+	node, exists := projectFile.packageRefNodes.Get(dependency.Name)
+	if !exists {
+		return false
+	}
+
+	if !projectFile.project.ReplaceDependency(dependency, newDependency) {
+		return false
+	}
+
+	projectFile.versionUpdates = append(projectFile.versionUpdates, dependencyVersionUpdate{
+		OldDependency: dependency,
+		NewDependency: newDependency,
+		OldVersion:    utils.ValueOrDefault(getVersionOverride(node), getPackageReferenceVersion(node)),
+		IsCentral:     isCentral,
+	})
+	if !isCentral {
+		setPackageVersion(node, newDependency.Version)
+		projectFile.isDirty = true
+	}
+	return true
+}
+
+func setPackageVersion(packageReference *etree.Element, version string) {
+	overrideAttr := packageReference.SelectAttr("VersionOverride")
+	if overrideAttr != nil {
+		overrideAttr.Value = version
+		return
+	}
+
+	versionAttr := packageReference.SelectAttr("Version")
+	if versionAttr != nil {
+		versionAttr.Value = version
+		return
+	}
+
+	versionNode := packageReference.SelectElement("Version")
+	if versionNode != nil {
+		versionNode.SetText(version)
+		return
+	}
+	packageReference.CreateAttr("Version", version)
+}
+
+// Returns the version of the package reference as written in the file (eg: "1.*" or "" if missing)
+func (projectFile *DotNetProjectFile) GetPackageVersion(packageName string) (string, bool) {
+	node, exists := projectFile.packageRefNodes.Get(packageName)
+	if !exists {
+		return "", false
+	}
+	return getPackageReferenceVersion(node), true
+}
+
+// Returns the "VersionOverride" of the package reference, or "" if none
+func (projectFile *DotNetProjectFile) GetVersionOverride(packageName string) string {
+	node, exists := projectFile.packageRefNodes.Get(packageName)
+	if !exists {
+		return ""
+	}
+	return getVersionOverride(node)
+}
+
+// Removes the "VersionOverride" of the package reference, making the project use the central package version (if any, nil otherwise)
+func (projectFile *DotNetProjectFile) RemoveVersionOverride(packageName string, centralPackage *PackageInfo) bool {
+	node, exists := projectFile.packageRefNodes.Get(packageName)
+	if !exists {
+		return false
+	}
+
+	overrideAttr := node.RemoveAttr("VersionOverride")
+	if overrideAttr == nil {
+		return false
+	}
+
+	project := projectFile.project
+	removedOverride := removedVersionOverride{
+		node:    node,
+		version: overrideAttr.Value,
+	}
+	dependency, _ := utils.FirstOrDefault(project.Dependencies, func(dependency *PackageInfo) bool {
+		return dependency.Name == packageName
+	})
+	if dependency != nil && centralPackage != nil && dependency != centralPackage {
+		project.ReplaceDependency(dependency, centralPackage)
+		removedOverride.oldDependency = dependency
+		removedOverride.newDependency = centralPackage
+	}
+	projectFile.removedOverrides = append(projectFile.removedOverrides, removedOverride)
+	projectFile.isDirty = true
+	return true
+}
+
+// Restores the version as written before an update (which might have been a floating or a missing version)
+func restorePackageVersion(packageReference *etree.Element, version string) {
+	if version != "" {
+		setPackageVersion(packageReference, version)
+		return
+	}
+
+	packageReference.RemoveAttr("Version")
+	versionNode := packageReference.SelectElement("Version")
+	if versionNode != nil {
+		packageReference.RemoveChild(versionNode)
+	}
+}
+
+// Returns the "Include" path of the project reference (as written in the file)
+func (projectFile *DotNetProjectFile) GetProjectReferencePath(projectName string) (string, bool) {
+	node, exists := projectFile.projectRefNodes.Get(projectName)
+	if !exists {
+		return "", false
+	}
+	return node.SelectAttrValue("Include", ""), true
+}
+
+func (projectFile *DotNetProjectFile) UpdateProjectReferencePath(projectName string, newPath string) bool {
+	node, exists := projectFile.projectRefNodes.Get(projectName)
+	if !exists {
+		return false
+	}
+
+	includeAttr := node.SelectAttr("Include")
+	projectFile.pathUpdates = append(projectFile.pathUpdates, projectReferencePathUpdate{
+		node:    node,
+		oldPath: includeAttr.Value,
+	})
+	includeAttr.Value = newPath
+	projectFile.isDirty = true
+	return true
+}
+
+// Rewrites the "<TargetFramework>" of the project.
+//
+// NOTE: Dependencies keep the assets resolved for the previous framework until the solution is reloaded
+func (projectFile *DotNetProjectFile) UpdateTargetFramework(framework string) {
+	if projectFile.originalFramework == "" {
+		projectFile.originalFramework = projectFile.project.Framework
+	}
+	projectFile.targetFrameworkNode.SetText(framework)
+	projectFile.project.Framework = framework
+	projectFile.isDirty = true
+}
+
+// Removes the "Version" of every package reference, making the project use the given central package versions
+func (projectFile *DotNetProjectFile) StripPackageVersions(centralPackages map[string]*PackageInfo) {
+	for _, packageName := range projectFile.packageRefNodes.GetOrderedKeys() {
+		projectFile.StripPackageVersion(packageName, centralPackages[packageName])
+	}
+	projectFile.isDirty = true
+}
+
+// Removes the "Version" of the package reference, making the project use the central package version (if any, nil otherwise)
+func (projectFile *DotNetProjectFile) StripPackageVersion(packageName string, centralPackage *PackageInfo) bool {
+	node, exists := projectFile.packageRefNodes.Get(packageName)
+	if !exists {
+		return false
+	}
+
+	project := projectFile.project
+	strippedVersion := stripPackageVersion(node)
+	dependency, _ := utils.FirstOrDefault(project.Dependencies, func(dependency *PackageInfo) bool {
+		return dependency.Name == packageName
+	})
+	if dependency != nil && centralPackage != nil && dependency != centralPackage {
+		project.ReplaceDependency(dependency, centralPackage)
+		strippedVersion.oldDependency = dependency
+		strippedVersion.newDependency = centralPackage
+	}
+	projectFile.strippedVersions = append(projectFile.strippedVersions, strippedVersion)
+	projectFile.isDirty = true
+	return true
+}
+
+// Turns the "Version" of the package reference into a "VersionOverride" (the project keeps using its own version)
+func (projectFile *DotNetProjectFile) ConvertToVersionOverride(packageName string) bool {
+	node, exists := projectFile.packageRefNodes.Get(packageName)
+	if !exists {
+		return false
+	}
+
+	strippedVersion := stripPackageVersion(node)
+	if strippedVersion.version == "" {
+		return false
+	}
+
+	node.CreateAttr("VersionOverride", strippedVersion.version)
+	strippedVersion.isOverride = true
+	projectFile.strippedVersions = append(projectFile.strippedVersions, strippedVersion)
+	projectFile.isDirty = true
+	return true
+}
+
+func stripPackageVersion(packageReference *etree.Element) strippedPackageVersion {
+	strippedVersion := strippedPackageVersion{
+		node: packageReference,
+	}
+
+	versionAttr := packageReference.RemoveAttr("Version")
+	if versionAttr != nil {
+		strippedVersion.version = versionAttr.Value
+		return strippedVersion
+	}
+
+	versionNode := packageReference.SelectElement("Version")
+	if versionNode != nil {
+		strippedVersion.version = versionNode.Text()
+		strippedVersion.versionNode = versionNode
+		strippedVersion.versionIndex = versionNode.Index()
+		packageReference.RemoveChild(versionNode)
+		if len(packageReference.ChildElements()) == 0 {
+			strippedVersion.blankNodes = slices.Clone(packageReference.Child)
+			for _, blankNode := range strippedVersion.blankNodes {
+				packageReference.RemoveChild(blankNode)
+			}
+		}
+	}
+	return strippedVersion
+}
+
+func (projectFile *DotNetProjectFile) restoreStrippedVersions(strippedVersions []strippedPackageVersion) {
+	for index := len(strippedVersions) - 1; index >= 0; index-- {
+		strippedVersion := strippedVersions[index]
+		if strippedVersion.isOverride {
+			strippedVersion.node.RemoveAttr("VersionOverride")
+		}
+		for _, blankNode := range strippedVersion.blankNodes {
+			strippedVersion.node.AddChild(blankNode)
+		}
+		if strippedVersion.versionNode != nil {
+			strippedVersion.node.InsertChildAt(strippedVersion.versionIndex, strippedVersion.versionNode)
+		} else if strippedVersion.version != "" {
+			strippedVersion.node.CreateAttr("Version", strippedVersion.version)
+		}
+
+		if strippedVersion.newDependency != nil {
+			projectFile.project.ReplaceDependency(strippedVersion.newDependency, strippedVersion.oldDependency)
+		}
+	}
+}
+
 func (projectFile *DotNetProjectFile) RevertChanges(hasGlobalPackages bool) {
-	//Back up removed dependencies to avoid re-removal afterwards :)
+	//Back up tracked changes to avoid re-tracking them afterwards :)
 	removedDependencies := projectFile.removedDependencies
+	versionUpdates := projectFile.versionUpdates
 	projectFile.removedDependencies = nil
+	projectFile.versionUpdates = nil
+	projectFile.restoreStrippedVersions(projectFile.strippedVersions)
+	for index := len(projectFile.removedOverrides) - 1; index >= 0; index-- {
+		removedOverride := projectFile.removedOverrides[index]
+		removedOverride.node.CreateAttr("VersionOverride", removedOverride.version)
+		if removedOverride.newDependency != nil {
+			projectFile.project.ReplaceDependency(removedOverride.newDependency, removedOverride.oldDependency)
+		}
+	}
+	for index := len(projectFile.pathUpdates) - 1; index >= 0; index-- {
+		pathUpdate := projectFile.pathUpdates[index]
+		pathUpdate.node.CreateAttr("Include", pathUpdate.oldPath)
+	}
+	if projectFile.originalFramework != "" {
+		projectFile.targetFrameworkNode.SetText(projectFile.originalFramework)
+		projectFile.project.Framework = projectFile.originalFramework
+	}
 	for _, dependency := range projectFile.addedDependencies {
 		projectFile.RemoveDependency(dependency)
 	}
 	for _, dependency := range removedDependencies {
 		projectFile.AddDependency(dependency, hasGlobalPackages)
 	}
+
+	//Undo version updates in reverse order (a package may have been updated more than once)
+	for index := len(versionUpdates) - 1; index >= 0; index-- {
+		versionUpdate := versionUpdates[index]
+		projectFile.updateDependencyVersion(versionUpdate.NewDependency, versionUpdate.OldDependency, versionUpdate.IsCentral)
+		if !versionUpdate.IsCentral {
+			node, _ := projectFile.packageRefNodes.Get(versionUpdate.OldDependency.Name)
+			restorePackageVersion(node, versionUpdate.OldVersion)
+		}
+	}
 	projectFile.resetTracking()
 }
 
-func (projectFile *DotNetProjectFile) Commit() error {
+// Stages the project file's content into the transaction (tracking is reset once the transaction got committed)
+func (projectFile *DotNetProjectFile) StageChanges(transaction *helpers.FileTransaction) error {
 	if !projectFile.isDirty {
 		return nil
 	}
@@ -213,24 +534,22 @@ func (projectFile *DotNetProjectFile) Commit() error {
 	filePath := projectFile.xmlFile.FilePath
 	log.Println("Writing:", filePath)
 
-	file, err := os.Create(filePath)
+	var buffer bytes.Buffer
+	xmlWriter := helpers.NewCustomXMLWriter(&buffer, "  ", true)
+	err := projectFile.xmlFile.Commit(xmlWriter)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
-
-	xmlWriter := helpers.NewCustomXMLWriter(file, "  ", true)
-	err = projectFile.xmlFile.Commit(xmlWriter)
-	if err != nil {
-		return err
-	}
-
-	projectFile.resetTracking()
-	return nil
+	return transaction.Stage(filePath, buffer.Bytes())
 }
 
 func (projectFile *DotNetProjectFile) resetTracking() {
 	projectFile.removedDependencies = nil
 	projectFile.addedDependencies = nil
+	projectFile.versionUpdates = nil
+	projectFile.strippedVersions = nil
+	projectFile.removedOverrides = nil
+	projectFile.pathUpdates = nil
+	projectFile.originalFramework = ""
 	projectFile.isDirty = false
 }
@@ -10,30 +10,51 @@ import (
 	"redun-pendancy/helpers"
 	"redun-pendancy/utils"
 	"regexp"
+	"slices"
+	"sort"
 	"strings"
+
+	"github.com/beevik/etree"
 )
 
+const packagePropsFileName = "Directory.Packages.props"
+
+var versionAttributeRegex = regexp.MustCompile(`\sVersion="[^"]*"`)
+
 type DotNetProjectHandler struct {
 	packageContainer *PackageContainer
 	packageManager   *DotNetPackageManager
+	projectLoader    *DotNetProjectLoader
+	resolver         *NuGetDependencyResolver
 	projectFiles     map[string]*DotNetProjectFile
+	resolutions      map[*PackageInfo]*ProjectResolution //Project => ProjectResolution
 
-	projects     []*PackageInfo
-	solutionName string
+	projects           []*PackageInfo
+	solutionName       string
+	solutionFolderPath string
+
+	solutionFile       *helpers.LazyBufferedFile
+	hasSolutionChanges bool
+	committedProjects  []*PackageInfo //Projects in the solution file (as written on disk)
 
 	globalPackages    map[string]string //PackageName => Version
+	globalReferences  map[string]string //PackageName => Version ("GlobalPackageReference", implicitly referenced by every project)
 	hasGlobalPackages bool
 
-	globalPackagesFile  *helpers.LazyBufferedFile
-	hasGlobalPkgChanges bool
+	globalPackagesFile    *helpers.LazyBufferedFile
+	hasGlobalPkgChanges   bool
+	createdGlobalPkgsFile bool
 }
 
 func NewDotNetProjectHandler(userHomePath string) *DotNetProjectHandler {
 	packageContainer := base.NewPackageContainer()
+	packageManager := NewNuGetPackageManager(userHomePath, packageContainer)
 	return &DotNetProjectHandler{
 		packageContainer: packageContainer,
-		packageManager:   NewNuGetPackageManager(userHomePath, packageContainer),
+		packageManager:   packageManager,
+		resolver:         NewNuGetDependencyResolver(packageContainer, packageManager),
 		projectFiles:     make(map[string]*DotNetProjectFile),
+		resolutions:      make(map[*PackageInfo]*ProjectResolution),
 	}
 }
 
@@ -49,18 +70,33 @@ func (projectHandler *DotNetProjectHandler) GetPackageContainer() *PackageContai
 	return projectHandler.packageContainer
 }
 
+func (projectHandler *DotNetProjectHandler) GetPackageManager() base.PackageManager {
+	return projectHandler.packageManager
+}
+
+func (projectHandler *DotNetProjectHandler) GetDiagnostics() []*Diagnostic {
+	return projectHandler.packageContainer.GetDiagnostics()
+}
+
 func (projectHandler *DotNetProjectHandler) Initialize(solutionFilePath string) error {
 	projectPaths, err := extractProjectPaths(solutionFilePath)
 	if err != nil {
 		return err
 	}
 
-	globalPackages, err := projectHandler.loadGlobalPackages(solutionFilePath)
+	globalPackages, globalReferences, err := projectHandler.loadGlobalPackages(solutionFilePath)
+	if err != nil {
+		return err
+	}
+
+	err = projectHandler.loadLocalFeeds(solutionFilePath)
 	if err != nil {
 		return err
 	}
 
-	projectLoader := NewDotNetProjectLoader(projectHandler.packageContainer, globalPackages)
+	projectLoader := NewDotNetProjectLoader(projectHandler.packageContainer, projectHandler.packageManager, globalPackages)
+	projectHandler.projectLoader = projectLoader
+	projectHandler.globalReferences = globalReferences
 	for _, projectFilePath := range projectPaths {
 		projectFile, err := projectLoader.GetOrLoad(projectFilePath)
 		if err != nil {
@@ -73,61 +109,144 @@ func (projectHandler *DotNetProjectHandler) Initialize(solutionFilePath string)
 	}
 
 	fmt.Println()
-	err = projectHandler.initProjects()
+	projectHandler.initProjects()
+
+	solutionFile, err := helpers.NewLazyBufferedFile(solutionFilePath)
 	if err != nil {
 		return err
 	}
+	projectHandler.solutionFile = solutionFile
+	projectHandler.committedProjects = projectHandler.projects
 
 	projectHandler.globalPackages = globalPackages
 	projectHandler.solutionName = filepath.Base(solutionFilePath)
+	projectHandler.solutionFolderPath = filepath.Dir(solutionFilePath)
 	projectHandler.hasGlobalPackages = len(globalPackages) != 0
 	return nil
 }
 
-func (projectHandler *DotNetProjectHandler) loadGlobalPackages(solutionFilePath string) (map[string]string, error) {
+// Returns the central package versions ("PackageVersion") & the global package references ("GlobalPackageReference") of the solution
+func (projectHandler *DotNetProjectHandler) loadGlobalPackages(solutionFilePath string) (map[string]string, map[string]string, error) {
 	folderPath := filepath.Dir(solutionFilePath)
-	packagePropsFilePath := path.Join(folderPath, "Directory.Packages.props")
+	packagePropsFilePath := path.Join(folderPath, packagePropsFileName)
 	globalPackagesFile, err := helpers.NewLazyBufferedFile(packagePropsFilePath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	packageVersions := make(map[string]string)
 	lines, err := globalPackagesFile.GetLines()
 	if err == nil {
-		for _, line := range lines {
-			processPackagePropsLine(line, packageVersions)
+		packageVersions, globalReferences, err := parsePackageProps(lines)
+		if err != nil {
+			return nil, nil, fmt.Errorf(`"%s" failed to load: %w`, packagePropsFilePath, err)
 		}
 		projectHandler.globalPackagesFile = globalPackagesFile
-		return packageVersions, nil
+		return packageVersions, globalReferences, nil
 	}
 
 	if os.IsNotExist(err) {
 		//Optional file, safe to continue without it.
 		fmt.Printf("Solution has no \"Directory.Packages.props\".\n\n")
-		return packageVersions, nil
+		return make(map[string]string), make(map[string]string), nil
 	}
-	return nil, err
+	return nil, nil, err
 }
 
-func processPackagePropsLine(line string, packageVersions map[string]string) {
-	if !strings.Contains(line, `<PackageVersion Include="`) {
-		return
+// Reads the "PackageVersion" & "GlobalPackageReference" items of "Directory.Packages.props" (items may span multiple lines).
+// Missing versions are kept as "" (reported by the analyzers)
+func parsePackageProps(lines []string) (map[string]string, map[string]string, error) {
+	document := etree.NewDocument()
+	err := document.ReadFromString(strings.Join(lines, "\n"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	packageVersions := make(map[string]string)
+	globalReferences := make(map[string]string)
+	projectNode := document.SelectElement("Project")
+	if projectNode == nil {
+		return packageVersions, globalReferences, nil
 	}
-	parts := strings.Split(line, "\"")
-	packageName := parts[1]
-	version := parts[3]
-	packageVersions[packageName] = version
+
+	for _, itemGroup := range projectNode.SelectElements("ItemGroup") {
+		for _, item := range itemGroup.ChildElements() {
+			packageName := item.SelectAttrValue("Include", "")
+			if packageName == "" {
+				continue
+			}
+
+			switch item.Tag {
+			case "PackageVersion":
+				packageVersions[packageName] = getPackageReferenceVersion(item)
+			case "GlobalPackageReference":
+				globalReferences[packageName] = getPackageReferenceVersion(item)
+			}
+		}
+	}
+	return packageVersions, globalReferences, nil
+}
+
+func (projectHandler *DotNetProjectHandler) loadLocalFeeds(solutionFilePath string) error {
+	feedPaths, err := extractLocalFeedPaths(solutionFilePath)
+	if err != nil {
+		return err
+	}
+
+	//The "packages" folder next to the solution is always searched last (packages.config style restores)
+	folderPath := filepath.Dir(solutionFilePath)
+	feedPaths = append(feedPaths, filepath.Join(folderPath, "packages"))
+
+	packageManager := projectHandler.packageManager
+	for _, feedPath := range feedPaths {
+		packageManager.AddLocalFeed(feedPath)
+	}
+	return nil
 }
 
-func (projectHandler *DotNetProjectHandler) initProjects() error {
+func (projectHandler *DotNetProjectHandler) initProjects() {
 	for _, project := range projectHandler.projects {
-		err := projectHandler.packageContainer.Load(project, projectHandler.packageManager, project.Framework)
-		if err != nil {
-			return err
+		projectHandler.GetResolution(project)
+	}
+	projectHandler.packageContainer.MarkUnloadedAsSkipped()
+}
+
+// Returns the effective package versions of the project (resolved lazily, as dependency changes invalidate them)
+func (projectHandler *DotNetProjectHandler) GetResolution(project *PackageInfo) *ProjectResolution {
+	resolution, exists := projectHandler.resolutions[project]
+	if exists {
+		return resolution
+	}
+
+	log.Println("Resolving:", project.ToString())
+	resolution = projectHandler.resolver.Resolve(project, projectHandler.getGlobalReferencePackages(project))
+	projectHandler.resolutions[project] = resolution
+	return resolution
+}
+
+// Returns the global package references as dependencies of the project (unless the project references the package itself)
+func (projectHandler *DotNetProjectHandler) getGlobalReferencePackages(project *PackageInfo) []*PackageInfo {
+	packageNames := utils.GetMapKeys(projectHandler.globalReferences)
+	sort.Strings(packageNames)
+
+	var globalReferencePackages []*PackageInfo
+	for _, packageName := range packageNames {
+		if project.ContainsDependency(packageName) {
+			continue
 		}
+
+		version := projectHandler.projectLoader.resolveVersion(packageName, projectHandler.globalReferences[packageName])
+		globalReferencePackages = append(globalReferencePackages, projectHandler.createVersionedPackage(project, packageName, version))
 	}
-	return nil
+	return globalReferencePackages
+}
+
+// Returns the namespaces the project's source files import (or fully qualify)
+func (projectHandler *DotNetProjectHandler) GetSourceNamespaces(project *PackageInfo) ([]string, error) {
+	return scanSourceNamespaces(project.FilePath)
+}
+
+func (projectHandler *DotNetProjectHandler) invalidateResolutions() {
+	projectHandler.resolutions = make(map[*PackageInfo]*ProjectResolution)
 }
 
 func (projectHandler *DotNetProjectHandler) GetProject(projectName string) *PackageInfo {
@@ -139,12 +258,339 @@ func (projectHandler *DotNetProjectHandler) GetProject(projectName string) *Pack
 
 func (projectHandler *DotNetProjectHandler) AddDependency(projectName string, dependency *PackageInfo) {
 	projectFile := projectHandler.projectFiles[projectName]
-	projectFile.AddDependency(dependency, projectHandler.hasGlobalPackages)
+	if projectFile.AddDependency(dependency, projectHandler.hasGlobalPackages) {
+		projectHandler.invalidateResolutions()
+	}
 }
 
 func (projectHandler *DotNetProjectHandler) RemoveDependency(projectName string, dependency *PackageInfo) {
 	projectFile := projectHandler.projectFiles[projectName]
-	projectFile.RemoveDependency(dependency)
+	if projectFile.RemoveDependency(dependency) {
+		projectHandler.invalidateResolutions()
+	}
+}
+
+// Returns the version of the package reference as written (eg: "1.*", "[1.0,)"), taken from "Directory.Packages.props" for centrally managed packages
+// (unless the project overrides it) & global package references
+func (projectHandler *DotNetProjectHandler) GetDeclaredVersion(projectName string, packageName string) string {
+	projectFile := projectHandler.projectFiles[projectName]
+	versionOverride := projectFile.GetVersionOverride(packageName)
+	if versionOverride != "" {
+		return versionOverride
+	}
+
+	centralVersion, isGlobalPackage := projectHandler.globalPackages[packageName]
+	if isGlobalPackage {
+		return centralVersion
+	}
+
+	version, exists := projectFile.GetPackageVersion(packageName)
+	if !exists {
+		version = projectHandler.globalReferences[packageName]
+	}
+	return version
+}
+
+// Returns the version written in the project file itself (ignoring "Directory.Packages.props"), or "" if none
+func (projectHandler *DotNetProjectHandler) GetLocalVersion(projectName string, packageName string) string {
+	projectFile := projectHandler.projectFiles[projectName]
+	version, _ := projectFile.GetPackageVersion(packageName)
+	return version
+}
+
+// Removes the local version of the package reference, making the project use the central one
+func (projectHandler *DotNetProjectHandler) StripLocalVersion(projectName string, packageName string) error {
+	projectFile, exists := projectHandler.projectFiles[projectName]
+	if !exists {
+		return fmt.Errorf(`project "%s" not found`, projectName)
+	}
+
+	var centralPackage *PackageInfo
+	centralVersion, isGlobalPackage := projectHandler.globalPackages[packageName]
+	if isGlobalPackage {
+		centralPackage = projectHandler.createVersionedPackage(projectFile.GetProject(), packageName, centralVersion)
+	}
+	if !projectFile.StripPackageVersion(packageName, centralPackage) {
+		return fmt.Errorf(`package "%s" is not referenced by "%s"`, packageName, projectName)
+	}
+	projectHandler.invalidateResolutions()
+	return nil
+}
+
+// Returns the "VersionOverride" of the package reference, or "" if none
+func (projectHandler *DotNetProjectHandler) GetVersionOverride(projectName string, packageName string) string {
+	projectFile := projectHandler.projectFiles[projectName]
+	return projectFile.GetVersionOverride(packageName)
+}
+
+// Removes the "VersionOverride" of the package reference, making the project use the central version
+func (projectHandler *DotNetProjectHandler) RemoveVersionOverride(projectName string, packageName string) error {
+	projectFile, exists := projectHandler.projectFiles[projectName]
+	if !exists {
+		return fmt.Errorf(`project "%s" not found`, projectName)
+	}
+
+	var centralPackage *PackageInfo
+	centralVersion, isGlobalPackage := projectHandler.globalPackages[packageName]
+	if isGlobalPackage {
+		centralPackage = projectHandler.createVersionedPackage(projectFile.GetProject(), packageName, centralVersion)
+	}
+	if !projectFile.RemoveVersionOverride(packageName, centralPackage) {
+		return fmt.Errorf(`package "%s" has no "VersionOverride" in "%s"`, packageName, projectName)
+	}
+	projectHandler.invalidateResolutions()
+	return nil
+}
+
+// Turns the local version of the package reference into a "VersionOverride" of the central one
+func (projectHandler *DotNetProjectHandler) ConvertToVersionOverride(projectName string, packageName string) error {
+	projectFile, exists := projectHandler.projectFiles[projectName]
+	if !exists {
+		return fmt.Errorf(`project "%s" not found`, projectName)
+	}
+	if !projectFile.ConvertToVersionOverride(packageName) {
+		return fmt.Errorf(`package "%s" has no local version in "%s"`, packageName, projectName)
+	}
+	return nil
+}
+
+// Updates the version of the dependency. Centrally managed packages get updated in "Directory.Packages.props" (for every project not overriding it)
+func (projectHandler *DotNetProjectHandler) UpdateDependencyVersion(projectName string, dependency *PackageInfo, version string) bool {
+	projectFile := projectHandler.projectFiles[projectName]
+	_, isGlobalPackage := projectHandler.globalPackages[dependency.Name]
+	if isGlobalPackage && projectFile.GetVersionOverride(dependency.Name) == "" {
+		return projectHandler.updateGlobalPackageVersion(dependency.Name, version)
+	}
+
+	newDependency := projectHandler.createVersionedPackage(projectFile.GetProject(), dependency.Name, version)
+	if !projectFile.UpdateDependencyVersion(dependency, newDependency) {
+		return false
+	}
+	projectHandler.invalidateResolutions()
+	return true
+}
+
+func (projectHandler *DotNetProjectHandler) updateGlobalPackageVersion(packageName string, version string) bool {
+	if projectHandler.globalPackages[packageName] == version {
+		//Already updated (eg: by another project's action)
+		return true
+	}
+
+	index, line, err := projectHandler.findPackageVersionLine(packageName)
+	if err != nil {
+		log.Printf("[Warning] Failed to update the central version: %v", err)
+		return false
+	}
+
+	newLine := versionAttributeRegex.ReplaceAllString(line, fmt.Sprintf(` Version="%s"`, version))
+	if !versionAttributeRegex.MatchString(line) {
+		includeAttribute := fmt.Sprintf(`Include="%s"`, packageName)
+		newLine = strings.Replace(line, includeAttribute, fmt.Sprintf(`%s Version="%s"`, includeAttribute, version), 1)
+	}
+	projectHandler.globalPackagesFile.SetLine(index, newLine)
+	projectHandler.globalPackages[packageName] = version
+	projectHandler.hasGlobalPkgChanges = true
+	projectHandler.updateCentralDependencies(packageName, version)
+	return true
+}
+
+// Makes every project referencing the package use its (new) central version, except for projects overriding it
+func (projectHandler *DotNetProjectHandler) updateCentralDependencies(packageName string, version string) {
+	for _, projectFile := range projectHandler.projectFiles {
+		if projectFile.GetVersionOverride(packageName) != "" {
+			continue
+		}
+
+		project := projectFile.GetProject()
+		dependency, exists := utils.FirstOrDefault(project.Dependencies, func(dependency *PackageInfo) bool {
+			return dependency.Name == packageName
+		})
+		if exists {
+			newDependency := projectHandler.createVersionedPackage(project, packageName, version)
+			projectFile.UpdateCentralDependencyVersion(dependency, newDependency)
+		}
+	}
+	projectHandler.invalidateResolutions()
+}
+
+// Swaps the package reference for the replacement package. Centrally managed packages also get their "PackageVersion" swapped
+func (projectHandler *DotNetProjectHandler) ReplaceDependency(projectName string, dependency *PackageInfo, replacementName string, version string) error {
+	projectFile, exists := projectHandler.projectFiles[projectName]
+	if !exists {
+		return fmt.Errorf(`project "%s" not found`, projectName)
+	}
+
+	//Validate the central entry before touching the project, a failed replacement must leave the project as is
+	_, isGlobalPackage := projectHandler.globalPackages[dependency.Name]
+	_, hasGlobalReplacement := projectHandler.globalPackages[replacementName]
+	if isGlobalPackage && !hasGlobalReplacement {
+		_, _, err := projectHandler.findPackageVersionLine(dependency.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !projectFile.RemoveDependency(dependency) {
+		return fmt.Errorf(`package "%s" is not referenced by "%s"`, dependency.Name, projectName)
+	}
+	if isGlobalPackage {
+		centralVersion, err := projectHandler.replaceGlobalPackage(dependency.Name, replacementName, version)
+		if err != nil {
+			return err
+		}
+		version = centralVersion
+	}
+
+	replacement := projectHandler.createVersionedPackage(projectFile.GetProject(), replacementName, version)
+	projectFile.AddDependency(replacement, projectHandler.hasGlobalPackages)
+	projectHandler.invalidateResolutions()
+	return nil
+}
+
+// Adds the "PackageVersion" of the replacement package (unless it already exists) and removes the replaced one once no project references it anymore.
+// Returns the central version of the replacement package
+func (projectHandler *DotNetProjectHandler) replaceGlobalPackage(packageName string, replacementName string, version string) (string, error) {
+	centralVersion, exists := projectHandler.globalPackages[replacementName]
+	if !exists {
+		index, line, err := projectHandler.findPackageVersionLine(packageName)
+		if err != nil {
+			return "", err
+		}
+
+		newLine := strings.Replace(line, fmt.Sprintf(`Include="%s"`, packageName), fmt.Sprintf(`Include="%s"`, replacementName), 1)
+		newLine = versionAttributeRegex.ReplaceAllString(newLine, fmt.Sprintf(` Version="%s"`, version))
+		projectHandler.globalPackagesFile.InsertLine(index+1, newLine)
+		projectHandler.globalPackages[replacementName] = version
+		projectHandler.hasGlobalPkgChanges = true
+		centralVersion = version
+	}
+
+	if !projectHandler.isPackageReferenced(packageName) {
+		projectHandler.RemoveGlobalPackage(packageName)
+		delete(projectHandler.globalPackages, packageName)
+	}
+	return centralVersion, nil
+}
+
+func (projectHandler *DotNetProjectHandler) isPackageReferenced(packageName string) bool {
+	for _, project := range projectHandler.projects {
+		_, exists := utils.FirstOrDefault(project.Dependencies, func(dependency *PackageInfo) bool {
+			return dependency.Name == packageName
+		})
+		if exists {
+			return true
+		}
+	}
+	return false
+}
+
+func (projectHandler *DotNetProjectHandler) createVersionedPackage(project *PackageInfo, packageName string, version string) *PackageInfo {
+	return projectHandler.packageContainer.GetOrCreatePackage(packageName, version, project.Framework, "NOT_LOADED")
+}
+
+// Removes the project from the solution file (the project file itself is left untouched), its dependencies no longer count it as a parent
+func (projectHandler *DotNetProjectHandler) RemoveProject(projectName string) error {
+	project := projectHandler.GetProject(projectName)
+	if project == nil {
+		return fmt.Errorf(`project "%s" not found`, projectName)
+	}
+
+	removed, err := removeSolutionProject(projectHandler.solutionFile, projectName)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return fmt.Errorf(`project "%s" not found in "%s"`, projectName, projectHandler.solutionName)
+	}
+
+	projectHandler.projects = utils.RemoveIf(projectHandler.projects, func(currProject *PackageInfo) bool {
+		return currProject == project
+	})
+	delete(projectHandler.projectFiles, projectName)
+	project.UnlinkDependencies()
+	projectHandler.hasSolutionChanges = true
+	projectHandler.invalidateResolutions()
+	return nil
+}
+
+// Returns the project references of the project, checked against the solution and the file system
+func (projectHandler *DotNetProjectHandler) GetProjectReferences(projectName string) []*ProjectReference {
+	projectFile := projectHandler.projectFiles[projectName]
+	references := []*ProjectReference{}
+	for _, dependency := range projectFile.GetProject().Dependencies {
+		includePath, exists := projectFile.GetProjectReferencePath(dependency.Name)
+		if !dependency.IsProject() || !exists {
+			continue
+		}
+
+		reference, err := checkProjectReference(projectFile, dependency, includePath)
+		if err != nil {
+			log.Printf(`[Warning] Failed to check the reference to "%s" in "%s": %v`, dependency.Name, projectName, err)
+			continue
+		}
+		reference.IsInSolution = projectHandler.GetProject(dependency.Name) != nil
+		references = append(references, reference)
+	}
+	return references
+}
+
+func (projectHandler *DotNetProjectHandler) UpdateProjectReferencePath(projectName string, referenceName string, newPath string) error {
+	projectFile := projectHandler.projectFiles[projectName]
+	if !projectFile.UpdateProjectReferencePath(referenceName, newPath) {
+		return fmt.Errorf(`project reference "%s" not found in "%s"`, referenceName, projectName)
+	}
+	return nil
+}
+
+// Rewrites the target framework of the project, as long as every package reference has assets compatible with it
+func (projectHandler *DotNetProjectHandler) RetargetProject(projectName string, framework string) error {
+	projectFile, exists := projectHandler.projectFiles[projectName]
+	if !exists {
+		return fmt.Errorf(`project "%s" not found`, projectName)
+	}
+
+	incompatiblePackages := projectHandler.packageManager.FindIncompatiblePackages(projectFile.GetProject(), framework)
+	if len(incompatiblePackages) != 0 {
+		packageNames := make([]string, len(incompatiblePackages))
+		for index, packageInfo := range incompatiblePackages {
+			packageNames[index] = packageInfo.ToString()
+		}
+		return fmt.Errorf(`cannot retarget "%s" to "%s", incompatible packages: %s`, projectName, framework, strings.Join(packageNames, ", "))
+	}
+
+	projectFile.UpdateTargetFramework(framework)
+	projectHandler.invalidateResolutions()
+	return nil
+}
+
+// Adds a project (only loaded as a reference so far) to the solution file
+func (projectHandler *DotNetProjectHandler) AddProjectToSolution(projectName string) error {
+	if projectHandler.GetProject(projectName) != nil {
+		return fmt.Errorf(`project "%s" is already part of "%s"`, projectName, projectHandler.solutionName)
+	}
+
+	projectFile := projectHandler.projectLoader.FindLoadedProject(projectName)
+	if projectFile == nil {
+		return fmt.Errorf(`project "%s" not found`, projectName)
+	}
+
+	project := projectFile.GetProject()
+	relativePath, err := filepath.Rel(projectHandler.solutionFolderPath, project.FilePath)
+	if err != nil {
+		return err
+	}
+
+	solutionPath := strings.ReplaceAll(filepath.ToSlash(relativePath), "/", `\`) //Solution files always use backslashes
+	err = addSolutionProject(projectHandler.solutionFile, projectName, solutionPath)
+	if err != nil {
+		return err
+	}
+
+	projectHandler.projectFiles[projectName] = projectFile
+	projectHandler.projects = append(slices.Clip(projectHandler.projects), project)
+	projectHandler.hasSolutionChanges = true
+	projectHandler.invalidateResolutions()
+	return nil
 }
 
 func (projectHandler *DotNetProjectHandler) DividesProjectsAndPackages(projectName string) bool {
@@ -166,58 +612,302 @@ func (projectHandler *DotNetProjectHandler) GetGlobalPackages() map[string]strin
 	return projectHandler.globalPackages
 }
 
+func (projectHandler *DotNetProjectHandler) GetGlobalReferences() map[string]string {
+	return projectHandler.globalReferences
+}
+
+// Returns whether the solution has a "Directory.Packages.props" file, even without any "<PackageVersion>" (eg: only global package references)
+func (projectHandler *DotNetProjectHandler) UsesCentralPackageManagement() bool {
+	return projectHandler.globalPackagesFile != nil
+}
+
+// Adds a "PackageVersion" to "Directory.Packages.props" (keeping the entries sorted, when they already are)
+func (projectHandler *DotNetProjectHandler) AddGlobalPackage(packageName string, version string) error {
+	if projectHandler.globalPackagesFile == nil {
+		return fmt.Errorf(`solution has no "%s" file`, packagePropsFileName)
+	}
+	_, exists := projectHandler.globalPackages[packageName]
+	if exists {
+		return fmt.Errorf(`package "%s" already has a version in "%s"`, packageName, packagePropsFileName)
+	}
+
+	globalPackagesFile := projectHandler.globalPackagesFile
+	lines, _ := globalPackagesFile.GetLines() //Ignoring error, as the file should already be loaded
+	insertIndex := -1
+	lastIndex := -1
+	for index, line := range lines {
+		currPackageName, isPackageVersion := utils.TrimPrefixIfMatch(strings.TrimSpace(line), `<PackageVersion Include="`)
+		if !isPackageVersion {
+			continue
+		}
+
+		lastIndex = index
+		currPackageName, _, _ = strings.Cut(currPackageName, `"`)
+		if insertIndex == -1 && strings.ToLower(currPackageName) > strings.ToLower(packageName) {
+			insertIndex = index
+		}
+	}
+
+	var indentation string
+	if lastIndex != -1 {
+		referenceLine := lines[utils.TernarySelect(insertIndex != -1, insertIndex, lastIndex)]
+		indentation = getIndentation(referenceLine)
+		insertIndex = utils.TernarySelect(insertIndex != -1, insertIndex, findItemEndIndex(lines, lastIndex)+1)
+	} else {
+		//No "<PackageVersion>" yet (eg: only "<GlobalPackageReference>" items)
+		var err error
+		insertIndex, indentation, err = prepareFirstPackageVersion(globalPackagesFile, lines)
+		if err != nil {
+			return err
+		}
+	}
+
+	newLine := fmt.Sprintf(`%s<PackageVersion Include="%s" Version="%s" />`, indentation, packageName, version)
+	globalPackagesFile.InsertLine(insertIndex, newLine)
+
+	projectHandler.globalPackages[packageName] = version
+	projectHandler.hasGlobalPkgChanges = true
+	projectHandler.updateCentralDependencies(packageName, version)
+	return nil
+}
+
+// Returns where the first "<PackageVersion>" goes (& its indentation): at the start of the first "<ItemGroup>", which gets created if there is none
+func prepareFirstPackageVersion(globalPackagesFile *helpers.LazyBufferedFile, lines []string) (int, string, error) {
+	for index, line := range lines {
+		trimmedLine := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmedLine, "<ItemGroup") && !strings.HasSuffix(trimmedLine, "/>") {
+			groupIndentation := getIndentation(line)
+			return index + 1, groupIndentation + utils.ValueOrDefault(groupIndentation, "  "), nil
+		}
+	}
+
+	projectEndIndex := utils.LastIndexOf(lines, len(lines)-1, func(line string) bool {
+		return strings.TrimSpace(line) == "</Project>"
+	})
+	if projectEndIndex == -1 {
+		return -1, "", fmt.Errorf(`no "</Project>" element found in "%s"`, packagePropsFileName)
+	}
+
+	const groupIndentation = "  "
+	globalPackagesFile.InsertLine(projectEndIndex, groupIndentation+"</ItemGroup>")
+	globalPackagesFile.InsertLine(projectEndIndex, groupIndentation+"<ItemGroup>")
+	return projectEndIndex + 1, groupIndentation + groupIndentation, nil
+}
+
+func getIndentation(line string) string {
+	return line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+}
+
 func (projectHandler *DotNetProjectHandler) RemoveGlobalPackage(packageName string) bool {
 	_, exists := projectHandler.globalPackages[packageName]
 	if !exists {
 		return false
 	}
 
-	globalPackagesFile := projectHandler.globalPackagesFile
-	pattern := fmt.Sprintf(`<PackageVersion Include="%s"`, packageName)
-	regex := regexp.MustCompile(pattern)
-	index, _ := globalPackagesFile.FindLineIndex(regex, 0) //Ignoring error, as the file should already be loaded
-	if index == -1 {
+	index, _, err := projectHandler.findPackageVersionLine(packageName)
+	if err != nil {
+		log.Printf("[Warning] Failed to remove the central version: %v", err)
 		return false
 	}
 
 	projectHandler.hasGlobalPkgChanges = true
-	globalPackagesFile.RemoveLine(index)
+	projectHandler.globalPackagesFile.RemoveLine(index)
 	return true
 }
 
+// Returns the line of the package's "PackageVersion" item. As the file is edited line by line, items spanning several lines are refused
+func (projectHandler *DotNetProjectHandler) findPackageVersionLine(packageName string) (int, string, error) {
+	globalPackagesFile := projectHandler.globalPackagesFile
+	pattern := fmt.Sprintf(`<PackageVersion Include="%s"`, regexp.QuoteMeta(packageName))
+	index, _ := globalPackagesFile.FindLineIndex(regexp.MustCompile(pattern), 0) //Ignoring error, as the file should already be loaded
+	if index == -1 {
+		return -1, "", fmt.Errorf(`package "%s" not found in "%s"`, packageName, packagePropsFileName)
+	}
+
+	line, _ := globalPackagesFile.GetLine(index)
+	if !strings.HasSuffix(strings.TrimSpace(line), "/>") {
+		return -1, "", fmt.Errorf(`the "PackageVersion" of package "%s" spans several lines in "%s" and has to be edited by hand`, packageName, packagePropsFileName)
+	}
+	return index, line, nil
+}
+
+// Returns the index of the line closing the item starting at "startIndex" (eg: "/>" or "</PackageVersion>")
+func findItemEndIndex(lines []string, startIndex int) int {
+	for index := startIndex; index < len(lines); index++ {
+		line := strings.TrimSpace(lines[index])
+		if strings.HasSuffix(line, "/>") || strings.HasSuffix(line, "</PackageVersion>") {
+			return index
+		}
+	}
+	return startIndex
+}
+
+// Creates a "Directory.Packages.props" file (with the given package versions) and strips the "Version" of every package reference
+func (projectHandler *DotNetProjectHandler) CentralizePackageVersions(packageVersions map[string]string) error {
+	if projectHandler.globalPackagesFile != nil {
+		return fmt.Errorf(`solution already has a "%s" file`, packagePropsFileName)
+	}
+
+	packagePropsFilePath := filepath.Join(projectHandler.solutionFolderPath, packagePropsFileName)
+	globalPackagesFile, err := helpers.NewLazyBufferedFile(packagePropsFilePath)
+	if err != nil {
+		return err
+	}
+	globalPackagesFile.SetLines(buildPackagePropsLines(packageVersions))
+
+	for _, projectFile := range projectHandler.projectFiles {
+		centralPackages := projectHandler.createCentralPackages(projectFile.GetProject(), packageVersions)
+		projectFile.StripPackageVersions(centralPackages)
+	}
+
+	projectHandler.globalPackages = packageVersions
+	projectHandler.hasGlobalPackages = true
+	projectHandler.globalPackagesFile = globalPackagesFile
+	projectHandler.hasGlobalPkgChanges = true
+	projectHandler.createdGlobalPkgsFile = true
+	projectHandler.invalidateResolutions()
+	return nil
+}
+
+func buildPackagePropsLines(packageVersions map[string]string) []string {
+	lines := []string{
+		"<Project>",
+		"  <PropertyGroup>",
+		"    <ManagePackageVersionsCentrally>true</ManagePackageVersionsCentrally>",
+		"  </PropertyGroup>",
+		"  <ItemGroup>",
+	}
+
+	packageNames := utils.GetMapKeys(packageVersions)
+	sort.Strings(packageNames)
+	for _, packageName := range packageNames {
+		line := fmt.Sprintf(`    <PackageVersion Include="%s" Version="%s" />`, packageName, packageVersions[packageName])
+		lines = append(lines, line)
+	}
+	return append(lines, "  </ItemGroup>", "</Project>", "")
+}
+
+func (projectHandler *DotNetProjectHandler) createCentralPackages(project *PackageInfo, packageVersions map[string]string) map[string]*PackageInfo {
+	centralPackages := make(map[string]*PackageInfo)
+	for packageName, version := range packageVersions {
+		centralPackages[packageName] = projectHandler.createVersionedPackage(project, packageName, version)
+	}
+	return centralPackages
+}
+
+// Writes every change as a whole: "Directory.Packages.props" first (stripped project files rely on it), then the projects & the solution.
+// Nothing gets written if any file fails to be written
 func (projectHandler *DotNetProjectHandler) CommitChanges() error {
+	transaction := helpers.NewFileTransaction()
+	err := projectHandler.stageChanges(transaction)
+	if err != nil {
+		transaction.Discard()
+		return err
+	}
+
+	err = transaction.Commit()
+	if err != nil {
+		return err
+	}
+
+	for _, projectFile := range projectHandler.projectFiles {
+		projectFile.resetTracking()
+	}
+	if projectHandler.hasGlobalPkgChanges {
+		projectHandler.hasGlobalPkgChanges = false
+		projectHandler.createdGlobalPkgsFile = false
+	}
+	if projectHandler.hasSolutionChanges {
+		projectHandler.hasSolutionChanges = false
+		projectHandler.committedProjects = projectHandler.projects
+	}
+	fmt.Println()
+	return nil
+}
+
+func (projectHandler *DotNetProjectHandler) stageChanges(transaction *helpers.FileTransaction) error {
+	if projectHandler.hasGlobalPkgChanges {
+		err := stageBufferedFile(transaction, projectHandler.globalPackagesFile)
+		if err != nil {
+			return err
+		}
+	}
 	for _, projectFile := range projectHandler.projectFiles {
-		err := projectFile.Commit()
+		err := projectFile.StageChanges(transaction)
 		if err != nil {
 			return err
 		}
 	}
-	if projectHandler.hasGlobalPkgChanges {
-		err := commitGlobalPackages(projectHandler.globalPackagesFile)
+	if projectHandler.hasSolutionChanges {
+		err := stageBufferedFile(transaction, projectHandler.solutionFile)
 		if err != nil {
 			return err
 		}
-		projectHandler.hasGlobalPkgChanges = false
 	}
-	fmt.Println()
 	return nil
 }
 
-func commitGlobalPackages(globalPackagesFile *helpers.LazyBufferedFile) error {
-	log.Println("Writing:", globalPackagesFile.FilePath)
-	err := globalPackagesFile.Commit()
-	return err
+func stageBufferedFile(transaction *helpers.FileTransaction, file *helpers.LazyBufferedFile) error {
+	log.Println("Writing:", file.FilePath)
+	content, err := file.GetContent()
+	if err != nil {
+		return err
+	}
+	return transaction.Stage(file.FilePath, []byte(content))
 }
 
 func (projectHandler *DotNetProjectHandler) RevertChanges() {
+	if projectHandler.createdGlobalPkgsFile {
+		projectHandler.discardGlobalPackagesFile()
+	}
+	if projectHandler.hasSolutionChanges {
+		//Before the project files, as removed projects get their project file back
+		projectHandler.revertSolution()
+	}
 	for _, projectFile := range projectHandler.projectFiles {
 		projectFile.RevertChanges(projectHandler.hasGlobalPackages)
 	}
+	projectHandler.invalidateResolutions()
 	if projectHandler.hasGlobalPkgChanges {
 		projectHandler.revertGlobalPackages()
 	}
 }
 
+func (projectHandler *DotNetProjectHandler) revertSolution() {
+	for _, project := range projectHandler.projects {
+		if !slices.Contains(projectHandler.committedProjects, project) {
+			//Added by "AddProjectToSolution()", it stays loaded as a project reference only
+			projectHandler.projectFiles[project.Name].RevertChanges(projectHandler.hasGlobalPackages)
+			delete(projectHandler.projectFiles, project.Name)
+		}
+	}
+	for _, project := range projectHandler.committedProjects {
+		_, exists := projectHandler.projectFiles[project.Name]
+		if !exists {
+			//Removed by "RemoveProject()"
+			project.LinkDependencies()
+			projectHandler.projectFiles[project.Name] = projectHandler.projectLoader.FindLoadedProject(project.Name)
+		}
+	}
+	projectHandler.projects = projectHandler.committedProjects
+	projectHandler.hasSolutionChanges = false
+
+	solutionFile := projectHandler.solutionFile
+	err := solutionFile.Reload()
+	if err != nil {
+		log.Printf(`[Warning] Failed to reload "%s": %v`, solutionFile.FilePath, err)
+	}
+}
+
+// Forgets the (not yet written) "Directory.Packages.props" file created by "CentralizePackageVersions()"
+func (projectHandler *DotNetProjectHandler) discardGlobalPackagesFile() {
+	projectHandler.globalPackages = make(map[string]string)
+	projectHandler.hasGlobalPackages = false
+	projectHandler.globalPackagesFile = nil
+	projectHandler.hasGlobalPkgChanges = false
+	projectHandler.createdGlobalPkgsFile = false
+}
+
 func (projectHandler *DotNetProjectHandler) revertGlobalPackages() {
 	globalPackagesFile := projectHandler.globalPackagesFile
 	err := globalPackagesFile.Reload()
@@ -225,5 +915,14 @@ func (projectHandler *DotNetProjectHandler) revertGlobalPackages() {
 		log.Printf(`[Warning] Failed to reload "%s": %v`, globalPackagesFile.FilePath, err)
 		return
 	}
+
+	lines, _ := globalPackagesFile.GetLines() //Ignoring error, as the file was just reloaded
+	packageVersions, globalReferences, err := parsePackageProps(lines)
+	if err != nil {
+		log.Printf(`[Warning] Failed to parse "%s": %v`, globalPackagesFile.FilePath, err)
+		return
+	}
+	projectHandler.globalPackages = packageVersions
+	projectHandler.globalReferences = globalReferences
 	projectHandler.hasGlobalPkgChanges = false
 }
@@ -5,20 +5,31 @@ import (
 	"log"
 	"path/filepath"
 	"redun-pendancy/helpers"
+	"redun-pendancy/models"
+	"redun-pendancy/utils"
+	"regexp"
+	"strings"
 
 	"github.com/beevik/etree"
 )
 
+const testSdkPackageName = "Microsoft.NET.Test.Sdk"
+
+// Naming conventions of test projects (eg: "Acme.Tests", "Acme.UnitTests", "Acme_IntegrationTest", "Acme.Specs")
+var testProjectNameRegex = regexp.MustCompile(`(?i)[._](Unit|Integration|Functional|Acceptance|E2E)?(Tests?|Specs?)$`)
+
 type DotNetProjectLoader struct {
 	packageContainer  *PackageContainer
+	packageManager    *DotNetPackageManager
 	loadedProjects    map[string]*DotNetProjectFile //Tracks loaded projects to ensure idempotency
 	globalPackages    map[string]string
 	hasGlobalPackages bool
 }
 
-func NewDotNetProjectLoader(packageContainer *PackageContainer, globalPackages map[string]string) *DotNetProjectLoader {
+func NewDotNetProjectLoader(packageContainer *PackageContainer, packageManager *DotNetPackageManager, globalPackages map[string]string) *DotNetProjectLoader {
 	return &DotNetProjectLoader{
 		packageContainer:  packageContainer,
+		packageManager:    packageManager,
 		loadedProjects:    make(map[string]*DotNetProjectFile),
 		globalPackages:    globalPackages,
 		hasGlobalPackages: len(globalPackages) != 0,
@@ -36,6 +47,16 @@ func (projectLoader *DotNetProjectLoader) GetOrLoad(projectPath string) (*DotNet
 	return projectFile, err
 }
 
+// Returns the already loaded project file (including projects only loaded as a reference) or nil if not found
+func (projectLoader *DotNetProjectLoader) FindLoadedProject(projectName string) *DotNetProjectFile {
+	for _, projectFile := range projectLoader.loadedProjects {
+		if projectFile.GetProject().Name == projectName {
+			return projectFile
+		}
+	}
+	return nil
+}
+
 func (projectLoader *DotNetProjectLoader) load(projectPath string) (*DotNetProjectFile, error) {
 	projectName := filepath.Base(projectPath)
 	log.Println("Reading:", projectName)
@@ -61,11 +82,16 @@ func (projectLoader *DotNetProjectLoader) load(projectPath string) (*DotNetProje
 	}
 
 	project := projectLoader.createProjectPackage(projectPath, targetFramework.Text())
-	projectFile := NewDotNetProjectFile(project, xmlFile)
+	extractProjectIdentity(projectNode, project)
+	projectFile := NewDotNetProjectFile(project, xmlFile, targetFramework)
 	projectLoader.loadedProjects[projectPath] = projectFile
 	if isExeProject || isWebProject(projectNode) {
 		project.MarkAsExeProject()
 	}
+	if isWebProject(projectNode) {
+		//The Web SDK implicitly references the ASP.NET Core shared framework
+		project.AddFrameworkReference(sharedFramework_AspNetCore)
+	}
 
 	projectFolderPath := filepath.Dir(projectPath)
 	for _, itemGroup := range projectNode.SelectElements("ItemGroup") {
@@ -74,6 +100,10 @@ func (projectLoader *DotNetProjectLoader) load(projectPath string) (*DotNetProje
 			return nil, err
 		}
 	}
+
+	if isTestProject(projectNode) || project.ContainsDependency(testSdkPackageName) || isTestProjectName(projectName) {
+		project.MarkAsTestProject()
+	}
 	return projectFile, nil
 }
 
@@ -96,11 +126,51 @@ func extractFrameworkAndExeFlag(projectNode *etree.Element) (*etree.Element, boo
 	return targetFramework, hasExeOutputType
 }
 
+// Reads the properties a project is published with as a package (values using MSBuild properties are ignored)
+func extractProjectIdentity(projectNode *etree.Element, project *PackageInfo) {
+	versionPrefix := ""
+	for _, propertyGroup := range projectNode.SelectElements("PropertyGroup") {
+		project.PackageId = utils.ValueOrDefault(project.PackageId, getLiteralProperty(propertyGroup, "PackageId"))
+		project.AssemblyName = utils.ValueOrDefault(project.AssemblyName, getLiteralProperty(propertyGroup, "AssemblyName"))
+		project.ProjectVersion = utils.ValueOrDefault(project.ProjectVersion, getLiteralProperty(propertyGroup, "Version"))
+		versionPrefix = utils.ValueOrDefault(versionPrefix, getLiteralProperty(propertyGroup, "VersionPrefix"))
+	}
+	project.ProjectVersion = utils.ValueOrDefault(project.ProjectVersion, versionPrefix)
+}
+
+func getLiteralProperty(propertyGroup *etree.Element, propertyName string) string {
+	property := propertyGroup.SelectElement(propertyName)
+	if property == nil {
+		return ""
+	}
+
+	value := strings.TrimSpace(property.Text())
+	if strings.Contains(value, "$(") {
+		return ""
+	}
+	return value
+}
+
 func checkOutputTypeExe(propertyGroup *etree.Element) bool {
 	outputType := propertyGroup.SelectElement("OutputType")
 	return outputType != nil && outputType.Text() == "Exe"
 }
 
+func isTestProject(projectNode *etree.Element) bool {
+	for _, propertyGroup := range projectNode.SelectElements("PropertyGroup") {
+		isTestProject := propertyGroup.SelectElement("IsTestProject")
+		if isTestProject != nil && strings.EqualFold(strings.TrimSpace(isTestProject.Text()), "true") {
+			return true
+		}
+	}
+	return false
+}
+
+func isTestProjectName(projectName string) bool {
+	baseName := strings.TrimSuffix(projectName, filepath.Ext(projectName))
+	return testProjectNameRegex.MatchString(baseName)
+}
+
 func isWebProject(projectNode *etree.Element) bool {
 	sdk := projectNode.SelectAttrValue("Sdk", "")
 	return sdk == "Microsoft.NET.Sdk.Web"
@@ -131,6 +201,9 @@ func (projectLoader *DotNetProjectLoader) processProjectItem(projectItem *etree.
 	if tag == "ProjectReference" {
 		return projectLoader.processProjectReference(projectItem, projectFile, projectFolderPath)
 	}
+	if tag == "FrameworkReference" {
+		return processFrameworkReference(projectItem, projectFile)
+	}
 	return nil
 }
 
@@ -140,34 +213,79 @@ func (projectLoader *DotNetProjectLoader) processPackageReference(packageReferen
 		return fmt.Errorf(`"Include=" attribute is missing in "<PackageReference>" element`)
 	}
 
-	version := packageReference.SelectAttrValue("Version", "")
+	version := getPackageReferenceVersion(packageReference)
 	if projectLoader.hasGlobalPackages {
-		localVersion := version
-
-		var exists bool
-		version, exists = projectLoader.globalPackages[packageName]
-		if !exists {
-			return fmt.Errorf(`"%s" package version not found in "Directory.Packages.props"`, packageName)
+		//NOTE: Local versions & packages missing from "Directory.Packages.props" are reported by the analyzers
+		centralVersion, exists := projectLoader.globalPackages[packageName]
+		if exists {
+			version = centralVersion
 		}
 
-		if localVersion != "" {
-			log.Printf(`[Warning] Found local version "%s" for package "%s", but solution has a "Directory.Packages.props" file\n`, localVersion, packageName)
+		//The project's own "VersionOverride" takes precedence over the central version
+		versionOverride := getVersionOverride(packageReference)
+		if versionOverride != "" {
+			version = versionOverride
 		}
 	}
 	project := projectFile.GetProject()
 	projectFile.AddPackageRefNode(packageName, packageReference)
+	version = projectLoader.resolveVersion(packageName, version)
 	dependency := projectLoader.packageContainer.GetOrCreatePackage(packageName, version, project.Framework, "NOT_LOADED")
 	project.AddDependency(dependency)
 	return nil
 }
 
+// Returns the "Version" attribute (or "<Version>" child element) of the package reference, or "" if missing
+func getPackageReferenceVersion(packageReference *etree.Element) string {
+	versionAttr := packageReference.SelectAttr("Version")
+	if versionAttr != nil {
+		return versionAttr.Value
+	}
+
+	versionNode := packageReference.SelectElement("Version")
+	if versionNode != nil {
+		return strings.TrimSpace(versionNode.Text())
+	}
+	return ""
+}
+
+// Returns the "VersionOverride" attribute of the package reference, or "" if missing
+func getVersionOverride(packageReference *etree.Element) string {
+	return packageReference.SelectAttrValue("VersionOverride", "")
+}
+
+// Resolves floating versions (eg: "1.*"), open ranges (eg: "[1.0,)") & missing versions to the version found in the NuGet cache
+func (projectLoader *DotNetProjectLoader) resolveVersion(packageName string, version string) string {
+	versionSpec := models.ParseVersionSpec(version)
+	if versionSpec.Kind == models.VersionSpecKind_Pinned {
+		return version
+	}
+
+	resolvedVersion := versionSpec.Resolve(projectLoader.packageManager.GetAvailableVersions(packageName))
+	if resolvedVersion == "" {
+		log.Printf(`[Warning] No version of package "%s" matching "%s" found in the NuGet cache`, packageName, version)
+		return versionSpec.GetMinVersion()
+	}
+	return resolvedVersion
+}
+
+func processFrameworkReference(frameworkReference *etree.Element, projectFile *DotNetProjectFile) error {
+	frameworkName := frameworkReference.SelectAttrValue("Include", "")
+	if frameworkName == "" {
+		return fmt.Errorf(`"Include=" attribute is missing in "<FrameworkReference>" element`)
+	}
+
+	projectFile.GetProject().AddFrameworkReference(getCanonicalFrameworkName(frameworkName))
+	return nil
+}
+
 func (projectLoader *DotNetProjectLoader) processProjectReference(projectReference *etree.Element, projectFile *DotNetProjectFile, projectFolderPath string) error {
 	relativePath := projectReference.SelectAttrValue("Include", "")
 	if relativePath == "" {
 		return fmt.Errorf(`"Include=" attribute is missing in "<ProjectReference>" element`)
 	}
 
-	projectReferencePath := filepath.Join(projectFolderPath, relativePath)
+	projectReferencePath := resolveIncludePath(projectFolderPath, relativePath)
 	referencedProject, err := projectLoader.GetOrLoad(projectReferencePath)
 	if err != nil {
 		return fmt.Errorf(`"%s" failed to load: %w`, projectReferencePath, err)
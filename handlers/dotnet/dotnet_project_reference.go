@@ -0,0 +1,97 @@
+package dotnet
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var windowsAbsolutePathRegex = regexp.MustCompile(`^([A-Za-z]:)?[\\/]`) //Matches: "C:\", "C:/", "\" & "/" prefixes
+
+// Checks the project reference path (as written) against the file on disk
+func checkProjectReference(projectFile *DotNetProjectFile, dependency *PackageInfo, includePath string) (*ProjectReference, error) {
+	diskFolderPath, err := getDiskPath(projectFile.GetFolderPath())
+	if err != nil {
+		return nil, err
+	}
+	diskFilePath, err := getDiskPath(dependency.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	relativePath, err := filepath.Rel(diskFolderPath, diskFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizedPath := formatIncludePath(relativePath, includePath)
+	cleanIncludePath := path.Clean(toSlashPath(includePath))
+	cleanRelativePath := filepath.ToSlash(relativePath)
+	return &ProjectReference{
+		Project:         dependency,
+		IncludePath:     includePath,
+		NormalizedPath:  normalizedPath,
+		IsAbsolute:      windowsAbsolutePathRegex.MatchString(includePath),
+		HasCaseMismatch: cleanIncludePath != cleanRelativePath && strings.EqualFold(cleanIncludePath, cleanRelativePath),
+	}, nil
+}
+
+// Returns the path with the letter case of every file & folder on disk (which might differ on case-insensitive file systems)
+func getDiskPath(filePath string) (string, error) {
+	absolutePath, err := filepath.Abs(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	volumeName := filepath.VolumeName(absolutePath)
+	diskPath := volumeName + string(filepath.Separator)
+	for _, segment := range strings.Split(absolutePath[len(volumeName):], string(filepath.Separator)) {
+		if segment == "" {
+			continue
+		}
+
+		entries, err := os.ReadDir(diskPath)
+		if err != nil {
+			return "", err
+		}
+		diskPath = filepath.Join(diskPath, findDiskName(entries, segment))
+	}
+	return diskPath, nil
+}
+
+func findDiskName(entries []os.DirEntry, name string) string {
+	diskName := name
+	for _, entry := range entries {
+		if entry.Name() == name {
+			return name
+		}
+		if strings.EqualFold(entry.Name(), name) {
+			diskName = entry.Name()
+		}
+	}
+	return diskName
+}
+
+// Uses the same separators as the original path (MSBuild files usually use backslashes)
+func formatIncludePath(relativePath string, originalPath string) string {
+	slashPath := filepath.ToSlash(relativePath)
+	if strings.Contains(originalPath, `\`) {
+		return strings.ReplaceAll(slashPath, "/", `\`)
+	}
+	return slashPath
+}
+
+// Resolves the "Include=" path against the folder of the project, unless it is already absolute
+func resolveIncludePath(folderPath string, includePath string) string {
+	filePath := filepath.FromSlash(toSlashPath(includePath))
+	if filepath.IsAbs(filePath) {
+		return filePath
+	}
+	return filepath.Join(folderPath, filePath)
+}
+
+// Converts Windows separators, which MSBuild files & solutions use on every OS
+func toSlashPath(filePath string) string {
+	return strings.ReplaceAll(filePath, `\`, "/")
+}
@@ -0,0 +1,142 @@
+package dotnet
+
+import (
+	"redun-pendancy/utils"
+	"strings"
+)
+
+const (
+	sharedFramework_NetCore    = "Microsoft.NETCore.App"
+	sharedFramework_AspNetCore = "Microsoft.AspNetCore.App"
+)
+
+// Shared frameworks are only checked from .NET 6 onwards (older ones lack many of the assemblies below)
+const minSharedFrameworkVersion = "6.0"
+
+// sharedFrameworkPackage is a package whose assemblies ship in a shared framework, starting from the given target framework version
+type sharedFrameworkPackage struct {
+	Name         string
+	SinceVersion string
+}
+
+// Packages already provided by each shared framework (not exhaustive, only the ones commonly referenced explicitly)
+var sharedFrameworkPackages = map[string][]sharedFrameworkPackage{
+	sharedFramework_NetCore: {
+		{"System.Buffers", "6.0"},
+		{"System.Collections.Immutable", "6.0"},
+		{"System.Diagnostics.DiagnosticSource", "6.0"},
+		{"System.Formats.Asn1", "6.0"},
+		{"System.Memory", "6.0"},
+		{"System.Net.Http", "6.0"},
+		{"System.Net.Http.Json", "6.0"},
+		{"System.Numerics.Vectors", "6.0"},
+		{"System.Reflection.Metadata", "6.0"},
+		{"System.Runtime.CompilerServices.Unsafe", "6.0"},
+		{"System.Text.Encoding.CodePages", "6.0"},
+		{"System.Text.Encodings.Web", "6.0"},
+		{"System.Text.Json", "6.0"},
+		{"System.Threading.Channels", "6.0"},
+		{"System.Threading.Tasks.Dataflow", "6.0"},
+		{"System.Threading.Tasks.Extensions", "6.0"},
+		{"System.ValueTuple", "6.0"},
+	},
+	sharedFramework_AspNetCore: {
+		{"Microsoft.AspNetCore.Authentication", "6.0"},
+		{"Microsoft.AspNetCore.Authentication.Cookies", "6.0"},
+		{"Microsoft.AspNetCore.Authorization", "6.0"},
+		{"Microsoft.AspNetCore.Cors", "6.0"},
+		{"Microsoft.AspNetCore.DataProtection", "6.0"},
+		{"Microsoft.AspNetCore.Diagnostics", "6.0"},
+		{"Microsoft.AspNetCore.Hosting", "6.0"},
+		{"Microsoft.AspNetCore.Hosting.Abstractions", "6.0"},
+		{"Microsoft.AspNetCore.Http", "6.0"},
+		{"Microsoft.AspNetCore.Http.Abstractions", "6.0"},
+		{"Microsoft.AspNetCore.Http.Features", "6.0"},
+		{"Microsoft.AspNetCore.HttpsPolicy", "6.0"},
+		{"Microsoft.AspNetCore.Mvc", "6.0"},
+		{"Microsoft.AspNetCore.Mvc.Core", "6.0"},
+		{"Microsoft.AspNetCore.ResponseCompression", "6.0"},
+		{"Microsoft.AspNetCore.Routing", "6.0"},
+		{"Microsoft.AspNetCore.Server.Kestrel", "6.0"},
+		{"Microsoft.AspNetCore.SignalR", "6.0"},
+		{"Microsoft.AspNetCore.StaticFiles", "6.0"},
+		{"Microsoft.AspNetCore.WebUtilities", "6.0"},
+		{"Microsoft.Extensions.Caching.Abstractions", "6.0"},
+		{"Microsoft.Extensions.Caching.Memory", "6.0"},
+		{"Microsoft.Extensions.Configuration", "6.0"},
+		{"Microsoft.Extensions.Configuration.Abstractions", "6.0"},
+		{"Microsoft.Extensions.Configuration.Binder", "6.0"},
+		{"Microsoft.Extensions.Configuration.CommandLine", "6.0"},
+		{"Microsoft.Extensions.Configuration.EnvironmentVariables", "6.0"},
+		{"Microsoft.Extensions.Configuration.FileExtensions", "6.0"},
+		{"Microsoft.Extensions.Configuration.Json", "6.0"},
+		{"Microsoft.Extensions.Configuration.UserSecrets", "6.0"},
+		{"Microsoft.Extensions.DependencyInjection", "6.0"},
+		{"Microsoft.Extensions.DependencyInjection.Abstractions", "6.0"},
+		{"Microsoft.Extensions.Diagnostics", "8.0"},
+		{"Microsoft.Extensions.Diagnostics.HealthChecks", "6.0"},
+		{"Microsoft.Extensions.Features", "7.0"},
+		{"Microsoft.Extensions.FileProviders.Abstractions", "6.0"},
+		{"Microsoft.Extensions.FileProviders.Physical", "6.0"},
+		{"Microsoft.Extensions.Hosting", "6.0"},
+		{"Microsoft.Extensions.Hosting.Abstractions", "6.0"},
+		{"Microsoft.Extensions.Http", "6.0"},
+		{"Microsoft.Extensions.Logging", "6.0"},
+		{"Microsoft.Extensions.Logging.Abstractions", "6.0"},
+		{"Microsoft.Extensions.Logging.Configuration", "6.0"},
+		{"Microsoft.Extensions.Logging.Console", "6.0"},
+		{"Microsoft.Extensions.Logging.Debug", "6.0"},
+		{"Microsoft.Extensions.Options", "6.0"},
+		{"Microsoft.Extensions.Options.ConfigurationExtensions", "6.0"},
+		{"Microsoft.Extensions.Primitives", "6.0"},
+		{"System.IO.Pipelines", "6.0"},
+	},
+}
+
+// Returns the canonical name of a known shared framework (framework references are case-insensitive, eg: "microsoft.aspnetcore.app"),
+// or the name as is for unknown ones
+func getCanonicalFrameworkName(frameworkName string) string {
+	for sharedFramework := range sharedFrameworkPackages {
+		if strings.EqualFold(sharedFramework, frameworkName) {
+			return sharedFramework
+		}
+	}
+	return frameworkName
+}
+
+// Returns the shared framework (referenced by the project) that already provides the package, or "" if none does.
+//
+// NOTE: Package versions newer than the target framework (eg: "System.Text.Json" 8.0 on "net6.0") are NOT provided, as they upgrade the framework assemblies.
+func findSharedFramework(project *PackageInfo, dependency *PackageInfo) string {
+	targetFramework, parsed := parseTargetFramework(project.Framework)
+	if !parsed || targetFramework.Family != targetFamily_NetCore || !isVersionAtLeast(targetFramework.Version, minSharedFrameworkVersion) {
+		return ""
+	}
+	if !isProvidedByFrameworkVersion(dependency.Version, targetFramework.Version) {
+		return ""
+	}
+
+	sharedFrameworks := append([]string{sharedFramework_NetCore}, project.FrameworkReferences...)
+	for _, sharedFramework := range sharedFrameworks {
+		_, isProvided := utils.FirstOrDefault(sharedFrameworkPackages[sharedFramework], func(sharedPackage sharedFrameworkPackage) bool {
+			return sharedPackage.Name == dependency.Name && isVersionAtLeast(targetFramework.Version, sharedPackage.SinceVersion)
+		})
+		if isProvided {
+			return sharedFramework
+		}
+	}
+	return ""
+}
+
+// The package major version must not be higher than the target framework one (eg: "System.Memory" 4.5.5 is provided by "net6.0")
+func isProvidedByFrameworkVersion(packageVersion string, frameworkVersion string) bool {
+	parsedPackageVersion, err := utils.ParsePackageVersion(packageVersion)
+	if err != nil {
+		return false
+	}
+	parsedFrameworkVersion, err := utils.ParsePackageVersion(frameworkVersion)
+	if err != nil {
+		return false
+	}
+	return parsedPackageVersion.Segments[0] <= parsedFrameworkVersion.Segments[0]
+}
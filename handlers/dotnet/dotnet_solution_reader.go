@@ -12,7 +12,7 @@ func extractProjectPaths(solutionFilePath string) ([]string, error) {
 	err := utils.OpenAndProcessFileLines(solutionFilePath, func(line string) error {
 		relativePath := processSolutionLine(line)
 		if relativePath != "" {
-			projectPath := filepath.Join(folderPath, relativePath)
+			projectPath := filepath.Join(folderPath, filepath.FromSlash(toSlashPath(relativePath)))
 			projectPaths = append(projectPaths, projectPath)
 		}
 		return nil
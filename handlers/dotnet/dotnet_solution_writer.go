@@ -0,0 +1,156 @@
+package dotnet
+
+import (
+	"crypto/rand"
+	"fmt"
+	"path"
+	"redun-pendancy/helpers"
+	"redun-pendancy/utils"
+	"strings"
+)
+
+const (
+	csharpProjectTypeGuid = "{FAE04EC0-301F-11D3-BF4B-00C04F79EFBC}"
+	vbProjectTypeGuid     = "{F184B08F-C81C-45F6-A57F-5ABD9991F28F}"
+)
+
+// Removes the project entry from the solution file, along with every line referencing its GUID
+// (eg: "ProjectConfigurationPlatforms", "NestedProjects" & "ProjectDependencies" sections). Returns false if not found
+func removeSolutionProject(solutionFile *helpers.LazyBufferedFile, projectName string) (bool, error) {
+	lines, err := solutionFile.GetLines()
+	if err != nil {
+		return false, err
+	}
+
+	startIndex := utils.IndexOf(lines, 0, func(line string) bool {
+		return isSolutionProjectLine(line, projectName)
+	})
+	if startIndex == -1 {
+		return false, nil
+	}
+
+	endIndex := utils.IndexOf(lines, startIndex, func(line string) bool {
+		return strings.TrimSpace(line) == "EndProject"
+	})
+	if endIndex == -1 {
+		return false, fmt.Errorf(`"EndProject" not found for project "%s" in: %s`, projectName, solutionFile.FilePath)
+	}
+
+	projectGuid := extractProjectGuid(lines[startIndex])
+	newLines := make([]string, 0, len(lines))
+	newLines = append(newLines, lines[:startIndex]...)
+	newLines = append(newLines, lines[endIndex+1:]...)
+	if projectGuid != "" {
+		newLines = utils.RemoveIf(newLines, func(line string) bool {
+			return strings.Contains(strings.ToUpper(line), projectGuid)
+		})
+	}
+	solutionFile.SetLines(newLines)
+	return true, nil
+}
+
+// Adds a project entry to the solution file, along with a build configuration entry for every solution configuration
+func addSolutionProject(solutionFile *helpers.LazyBufferedFile, projectName string, relativePath string) error {
+	lines, err := solutionFile.GetLines()
+	if err != nil {
+		return err
+	}
+
+	projectGuid, err := newProjectGuid()
+	if err != nil {
+		return err
+	}
+
+	typeGuid := utils.TernarySelect(strings.HasSuffix(relativePath, ".vbproj"), vbProjectTypeGuid, csharpProjectTypeGuid)
+	displayName := strings.TrimSuffix(projectName, path.Ext(projectName))
+	projectLines := []string{
+		fmt.Sprintf(`Project("%s") = "%s", "%s", "%s"`, typeGuid, displayName, relativePath, projectGuid),
+		"EndProject",
+	}
+
+	globalIndex := utils.IndexOf(lines, 0, func(line string) bool {
+		return strings.TrimSpace(line) == "Global"
+	})
+	if globalIndex == -1 {
+		solutionFile.SetLines(append(lines, projectLines...))
+		return nil
+	}
+
+	newLines := make([]string, 0, len(lines)+len(projectLines))
+	newLines = append(newLines, lines[:globalIndex]...)
+	newLines = append(newLines, projectLines...)
+	newLines = append(newLines, lines[globalIndex:]...)
+	solutionFile.SetLines(addProjectConfigurations(newLines, projectGuid))
+	return nil
+}
+
+// Builds the project in every solution configuration (eg: "{GUID}.Debug|Any CPU.ActiveCfg = Debug|Any CPU")
+func addProjectConfigurations(lines []string, projectGuid string) []string {
+	configurations := []string{}
+	sectionIndex := utils.IndexOf(lines, 0, func(line string) bool {
+		return strings.Contains(line, "GlobalSection(SolutionConfigurationPlatforms)")
+	})
+	for index := sectionIndex + 1; sectionIndex != -1 && index < len(lines); index++ {
+		line := strings.TrimSpace(lines[index])
+		if line == "EndGlobalSection" {
+			break
+		}
+		configuration, _, _ := strings.Cut(line, "=")
+		configurations = append(configurations, strings.TrimSpace(configuration))
+	}
+
+	endIndex := -1
+	projectSectionIndex := utils.IndexOf(lines, 0, func(line string) bool {
+		return strings.Contains(line, "GlobalSection(ProjectConfigurationPlatforms)")
+	})
+	if projectSectionIndex != -1 {
+		endIndex = utils.IndexOf(lines, projectSectionIndex, func(line string) bool {
+			return strings.TrimSpace(line) == "EndGlobalSection"
+		})
+	}
+	if len(configurations) == 0 || endIndex == -1 {
+		//Nothing to add to (the IDE adds the missing entries when opening the solution)
+		return lines
+	}
+
+	for _, configuration := range configurations {
+		lines = utils.InsertAt(lines, endIndex, fmt.Sprintf("\t\t%s.%s.ActiveCfg = %s", projectGuid, configuration, configuration))
+		lines = utils.InsertAt(lines, endIndex+1, fmt.Sprintf("\t\t%s.%s.Build.0 = %s", projectGuid, configuration, configuration))
+		endIndex += 2
+	}
+	return lines
+}
+
+// Returns a random (version 4) GUID, formatted as solution files do (eg: "{6F2A3B1C-...}")
+func newProjectGuid() (string, error) {
+	bytes := make([]byte, 16)
+	_, err := rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+	bytes[6] = (bytes[6] & 0x0F) | 0x40
+	bytes[8] = (bytes[8] & 0x3F) | 0x80
+	return fmt.Sprintf("{%X-%X-%X-%X-%X}", bytes[0:4], bytes[4:6], bytes[6:8], bytes[8:10], bytes[10:16]), nil
+}
+
+func isSolutionProjectLine(line string, projectName string) bool {
+	if !strings.HasPrefix(line, `Project("{`) {
+		return false
+	}
+
+	parts := strings.Split(line, "\"")
+	if len(parts) < 6 {
+		return false
+	}
+	projectPath := strings.ReplaceAll(parts[5], `\`, "/")
+	return strings.EqualFold(path.Base(projectPath), projectName)
+}
+
+// Returns the (upper case) GUID of the project line (eg: "{6F2A...}")
+func extractProjectGuid(line string) string {
+	parts := strings.Split(line, "\"")
+	if len(parts) < 8 {
+		return ""
+	}
+	return strings.ToUpper(parts[7])
+}
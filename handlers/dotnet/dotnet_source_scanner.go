@@ -0,0 +1,170 @@
+package dotnet
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"redun-pendancy/utils"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	csharpUsingRegex   = regexp.MustCompile(`^\s*(?:global\s+)?using\s+(?:static\s+)?(?:\w+\s*=\s*)?([\w.]+)\s*;`) //Matches: "using X.Y;", "global using X;", "using static X.Y;", "using Z = X.Y;"
+	vbImportsRegex     = regexp.MustCompile(`(?i)^\s*Imports\s+(?:\w+\s*=\s*)?([\w.]+)`)                           //Matches: "Imports X.Y", "Imports Z = X.Y"
+	qualifiedNameRegex = regexp.MustCompile(`\b[A-Z]\w*(?:\.[A-Z]\w*)+`)                                           //Matches fully qualified usages (eg: "Newtonsoft.Json.JsonConvert")
+)
+
+var sourceFileExtensions = map[string]*regexp.Regexp{
+	".cs": csharpUsingRegex,
+	".vb": vbImportsRegex,
+}
+
+// Scans the source files of the project folder (sub-projects, "bin" & "obj" folders excluded) for the namespaces they import or fully qualify
+func scanSourceNamespaces(projectFilePath string) ([]string, error) {
+	projectFolderPath := filepath.Dir(projectFilePath)
+	namespaces := utils.NewSet[string]()
+	err := filepath.WalkDir(projectFolderPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if filePath != projectFolderPath && isExcludedSourceFolder(filePath, entry.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		importRegex, isSourceFile := sourceFileExtensions[strings.ToLower(filepath.Ext(filePath))]
+		if !isSourceFile {
+			return nil
+		}
+		lineCleaner := &sourceLineCleaner{isVisualBasic: importRegex == vbImportsRegex}
+		err = utils.OpenAndProcessFileLines(filePath, func(line string) error {
+			processSourceLine(lineCleaner.clean(line), importRegex, namespaces)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf(`"%s" failed to be scanned: %w`, filePath, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortedNamespaces := utils.GetMapKeys(namespaces)
+	sort.Strings(sortedNamespaces)
+	return sortedNamespaces, nil
+}
+
+func isExcludedSourceFolder(folderPath string, folderName string) bool {
+	if strings.EqualFold(folderName, "bin") || strings.EqualFold(folderName, "obj") || strings.HasPrefix(folderName, ".") {
+		return true
+	}
+
+	//Folders with their own project file belong to another project
+	projectFiles, _ := filepath.Glob(filepath.Join(folderPath, "*.*proj"))
+	return len(projectFiles) != 0
+}
+
+func processSourceLine(line string, importRegex *regexp.Regexp, namespaces utils.Set[string]) {
+	matches := importRegex.FindStringSubmatch(line)
+	if matches != nil {
+		namespaces.Add(matches[1])
+		return
+	}
+	namespaces.AddRange(qualifiedNameRegex.FindAllString(line, -1))
+}
+
+// sourceLineCleaner blanks out the comments & string literals of source lines, so that only code gets scanned for namespaces.
+//
+// NOTE: C# block comments & verbatim strings may span several lines. Raw string literals (""") are not recognized and
+// interpolated strings are blanked out as a whole (including the code of their holes).
+type sourceLineCleaner struct {
+	isVisualBasic    bool
+	inBlockComment   bool
+	inVerbatimString bool
+}
+
+func (cleaner *sourceLineCleaner) clean(line string) string {
+	builder := strings.Builder{}
+	for index := 0; index < len(line); index++ {
+		char := line[index]
+		switch {
+		case cleaner.inBlockComment:
+			if strings.HasPrefix(line[index:], "*/") {
+				cleaner.inBlockComment = false
+				index++
+			}
+			continue
+
+		case cleaner.inVerbatimString:
+			if char == '"' {
+				if strings.HasPrefix(line[index:], `""`) {
+					index++ //Escaped quote
+					continue
+				}
+				cleaner.inVerbatimString = false
+			}
+			continue
+
+		case cleaner.isVisualBasic:
+			if char == '\'' {
+				return builder.String() //Comment
+			}
+			if char == '"' {
+				index = skipQuotedLiteral(line, index, false)
+				builder.WriteByte(' ')
+				continue
+			}
+
+		case strings.HasPrefix(line[index:], "//"):
+			return builder.String()
+
+		case strings.HasPrefix(line[index:], "/*"):
+			cleaner.inBlockComment = true
+			index++
+			builder.WriteByte(' ')
+			continue
+
+		case char == '"' && isVerbatimStringStart(line, index):
+			cleaner.inVerbatimString = true
+			builder.WriteByte(' ')
+			continue
+
+		case char == '"' || char == '\'':
+			index = skipQuotedLiteral(line, index, true)
+			builder.WriteByte(' ')
+			continue
+		}
+		builder.WriteByte(char)
+	}
+	return builder.String()
+}
+
+// Verbatim strings start with "@" (eg: @"C:\Temp", $@"{x}", @$"{x}")
+func isVerbatimStringStart(line string, quoteIndex int) bool {
+	prefix := line[:quoteIndex]
+	return strings.HasSuffix(prefix, "@") || strings.HasSuffix(prefix, "@$")
+}
+
+// Returns the index of the quote closing the literal (or the last index, for unterminated literals)
+func skipQuotedLiteral(line string, quoteIndex int, hasBackslashEscapes bool) int {
+	quote := line[quoteIndex]
+	for index := quoteIndex + 1; index < len(line); index++ {
+		if hasBackslashEscapes && line[index] == '\\' {
+			index++
+			continue
+		}
+		if line[index] == quote {
+			if !hasBackslashEscapes && strings.HasPrefix(line[index:], `""`) {
+				index++ //VB escapes quotes by doubling them
+				continue
+			}
+			return index
+		}
+	}
+	return len(line) - 1
+}
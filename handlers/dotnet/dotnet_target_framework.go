@@ -0,0 +1,138 @@
+package dotnet
+
+import (
+	"redun-pendancy/models"
+	"redun-pendancy/utils"
+	"regexp"
+	"strings"
+)
+
+const (
+	targetFamily_NetCore      = "netcoreapp" //Includes .NET 5+
+	targetFamily_NetFramework = "netframework"
+	targetFamily_NetStandard  = "netstandard"
+)
+
+// DotNetTargetFramework is a parsed target framework moniker (eg: "net8.0", "netstandard2.0", "net472", ".NETFramework4.7.2")
+type DotNetTargetFramework struct {
+	Family  string
+	Version string
+}
+
+// Minimum .NET Framework version implementing each .NET Standard version (.NET Framework never got .NET Standard 2.1)
+var netStandardFrameworkVersions = map[string]string{
+	"1.0": "4.5",
+	"1.1": "4.5",
+	"1.2": "4.5.1",
+	"1.3": "4.6",
+	"1.4": "4.6.1",
+	"1.5": "4.6.1",
+	"1.6": "4.6.1",
+	"2.0": "4.6.1",
+}
+
+var shortNetFrameworkRegex = regexp.MustCompile(`^net(\d)(\d)(\d?)$`) //Matches: net48, net472, etc
+var frameworkVersionRegex = regexp.MustCompile(`^\d+(\.\d+)*$`)
+
+func parseTargetFramework(framework string) (*DotNetTargetFramework, bool) {
+	framework = strings.ToLower(strings.TrimSpace(framework))
+	platformIndex := strings.Index(framework, "-")
+	if platformIndex != -1 {
+		//Ignore the platform (eg: "net8.0-windows")
+		framework = framework[:platformIndex]
+	}
+
+	prefixes := []struct {
+		prefix string
+		family string
+	}{
+		{".netstandard", targetFamily_NetStandard},
+		{"netstandard", targetFamily_NetStandard},
+		{".netcoreapp", targetFamily_NetCore},
+		{"netcoreapp", targetFamily_NetCore},
+		{".netframework", targetFamily_NetFramework},
+	}
+	for _, entry := range prefixes {
+		version, hadPrefix := utils.TrimPrefixIfMatch(framework, entry.prefix)
+		if hadPrefix {
+			return newTargetFramework(entry.family, strings.TrimPrefix(version, "v"))
+		}
+	}
+
+	matches := shortNetFrameworkRegex.FindStringSubmatch(framework)
+	if matches != nil {
+		version := matches[1] + "." + matches[2]
+		if matches[3] != "" {
+			version += "." + matches[3]
+		}
+		return newTargetFramework(targetFamily_NetFramework, version)
+	}
+
+	version, hadPrefix := utils.TrimPrefixIfMatch(framework, "net")
+	if hadPrefix && strings.Contains(version, ".") {
+		return newTargetFramework(targetFamily_NetCore, version)
+	}
+	return nil, false
+}
+
+func newTargetFramework(family string, version string) (*DotNetTargetFramework, bool) {
+	if !frameworkVersionRegex.MatchString(version) {
+		return nil, false
+	}
+	return &DotNetTargetFramework{
+		Family:  family,
+		Version: version,
+	}, true
+}
+
+// Checks whether a project targeting "framework" can consume a project/package targeting "targetFramework"
+func checkFrameworkCompatibility(framework string, targetFramework string) models.FrameworkCompatibility {
+	consumer, consumerParsed := parseTargetFramework(framework)
+	target, targetParsed := parseTargetFramework(targetFramework)
+	if !consumerParsed || !targetParsed {
+		return models.FrameworkCompatibility_Unknown
+	}
+
+	isCompatible := false
+	switch target.Family {
+	case targetFamily_NetStandard:
+		isCompatible = supportsNetStandard(consumer, target.Version)
+
+	case targetFamily_NetFramework:
+		if consumer.Family == targetFamily_NetCore && isVersionAtLeast(consumer.Version, "2.0") {
+			//.NET Core 2.0+ can use .NET Framework assets, although they might fail at runtime
+			return models.FrameworkCompatibility_FallbackOnly
+		}
+		isCompatible = consumer.Family == targetFamily_NetFramework && isVersionAtLeast(consumer.Version, target.Version)
+
+	case targetFamily_NetCore:
+		isCompatible = consumer.Family == targetFamily_NetCore && isVersionAtLeast(consumer.Version, target.Version)
+	}
+	return utils.TernarySelect(isCompatible, models.FrameworkCompatibility_Compatible, models.FrameworkCompatibility_Incompatible)
+}
+
+func supportsNetStandard(consumer *DotNetTargetFramework, netStandardVersion string) bool {
+	switch consumer.Family {
+	case targetFamily_NetStandard:
+		return isVersionAtLeast(consumer.Version, netStandardVersion)
+
+	case targetFamily_NetCore:
+		if isVersionAtLeast(consumer.Version, "3.0") {
+			return isVersionAtLeast("2.1", netStandardVersion)
+		}
+		if isVersionAtLeast(consumer.Version, "2.0") {
+			return isVersionAtLeast("2.0", netStandardVersion)
+		}
+		return isVersionAtLeast("1.6", netStandardVersion)
+
+	case targetFamily_NetFramework:
+		minimumVersion, exists := netStandardFrameworkVersions[netStandardVersion]
+		return exists && isVersionAtLeast(consumer.Version, minimumVersion)
+	}
+	return false
+}
+
+func isVersionAtLeast(version string, minimumVersion string) bool {
+	result, err := utils.CompareVersions(version, minimumVersion)
+	return err == nil && result >= 0
+}
@@ -0,0 +1,92 @@
+package dotnet
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+var nugetConfigFileNames = []string{"NuGet.Config", "NuGet.config", "nuget.config"}
+
+// Collects the local folder package sources from the "NuGet.Config" files found walking up from the solution folder.
+//
+// NOTE: Closer config files come first. A "<clear />" source stops the search (just like NuGet does).
+func extractLocalFeedPaths(solutionFilePath string) ([]string, error) {
+	feedPaths := []string{}
+	folderPath := filepath.Dir(solutionFilePath)
+	for {
+		configFilePath := findNuGetConfigFile(folderPath)
+		if configFilePath != "" {
+			configFeedPaths, cleared, err := readLocalFeedPaths(configFilePath)
+			if err != nil {
+				return nil, err
+			}
+			feedPaths = append(feedPaths, configFeedPaths...)
+			if cleared {
+				break
+			}
+		}
+
+		parentPath := filepath.Dir(folderPath)
+		if parentPath == folderPath {
+			break
+		}
+		folderPath = parentPath
+	}
+	return feedPaths, nil
+}
+
+func findNuGetConfigFile(folderPath string) string {
+	for _, fileName := range nugetConfigFileNames {
+		filePath := filepath.Join(folderPath, fileName)
+		_, err := os.Stat(filePath)
+		if err == nil {
+			return filePath
+		}
+	}
+	return ""
+}
+
+func readLocalFeedPaths(configFilePath string) ([]string, bool, error) {
+	document := etree.NewDocument()
+	err := document.ReadFromFile(configFilePath)
+	if err != nil {
+		return nil, false, err
+	}
+
+	packageSources := document.FindElement("//configuration/packageSources")
+	if packageSources == nil {
+		return nil, false, nil
+	}
+
+	feedPaths := []string{}
+	cleared := false
+	configFolderPath := filepath.Dir(configFilePath)
+	for _, sourceNode := range packageSources.ChildElements() {
+		if sourceNode.Tag == "clear" {
+			cleared = true
+			continue
+		}
+
+		sourcePath := sourceNode.SelectAttrValue("value", "")
+		if sourceNode.Tag != "add" || !isLocalSource(sourcePath) {
+			continue
+		}
+		feedPaths = append(feedPaths, resolveSourcePath(configFolderPath, sourcePath))
+	}
+	return feedPaths, cleared, nil
+}
+
+func isLocalSource(sourcePath string) bool {
+	return sourcePath != "" && !strings.Contains(sourcePath, "://")
+}
+
+func resolveSourcePath(configFolderPath string, sourcePath string) string {
+	sourcePath = filepath.FromSlash(strings.ReplaceAll(sourcePath, `\`, "/"))
+	if filepath.IsAbs(sourcePath) {
+		return sourcePath
+	}
+	return filepath.Join(configFolderPath, sourcePath)
+}
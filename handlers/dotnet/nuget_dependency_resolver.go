@@ -0,0 +1,165 @@
+package dotnet
+
+import (
+	"redun-pendancy/handlers/base"
+	"redun-pendancy/models"
+	"redun-pendancy/utils"
+)
+
+// NuGetDependencyResolver simulates how NuGet picks the effective version of every package in a project:
+//   - Direct dependency wins: A project's own reference always wins.
+//   - Nearest wins: The version closest to the project (in the dependency graph) wins.
+//   - Cousin dependencies: Versions required at the same depth resolve to the lowest version satisfying all of their ranges
+//     (which, for minimum version requirements, is the highest one). Disagreeing cousins are recorded as conflicts.
+//
+// NOTE: Packages are loaded lazily, only the effective versions have their dependencies fetched.
+type NuGetDependencyResolver struct {
+	packageContainer *PackageContainer
+	packageManager   base.PackageManager
+}
+
+func NewNuGetDependencyResolver(packageContainer *PackageContainer, packageManager base.PackageManager) *NuGetDependencyResolver {
+	return &NuGetDependencyResolver{
+		packageContainer: packageContainer,
+		packageManager:   packageManager,
+	}
+}
+
+// Resolves the project's dependency graph. Implicit dependencies (eg: global package references) are resolved as direct dependencies
+func (resolver *NuGetDependencyResolver) Resolve(project *PackageInfo, implicitDependencies []*PackageInfo) *ProjectResolution {
+	resolution := models.NewProjectResolution(project)
+	rootFramework := project.Framework
+	resolver.packageContainer.Load(project, resolver.packageManager, rootFramework)
+
+	candidates := append(createCandidates(project.Dependencies, nil), createCandidates(implicitDependencies, nil)...)
+	for len(candidates) != 0 {
+		winners := resolver.resolveLevel(resolution, candidates)
+		candidates = nil
+		for _, winner := range winners {
+			packageInfo := winner.PackageInfo
+			resolver.packageContainer.Load(packageInfo, resolver.packageManager, rootFramework)
+			candidates = append(candidates, createCandidates(packageInfo.Dependencies, winner.Path)...)
+		}
+	}
+	return resolution
+}
+
+func createCandidates(dependencies []*PackageInfo, parentPath []*PackageInfo) []*ResolvedPackage {
+	return utils.Map(dependencies, func(dependency *PackageInfo) *ResolvedPackage {
+		return models.NewResolvedPackage(dependency, parentPath)
+	})
+}
+
+// Resolves all the candidates of the same depth. Returns the newly resolved packages (to be expanded)
+func (resolver *NuGetDependencyResolver) resolveLevel(resolution *ProjectResolution, candidates []*ResolvedPackage) []*ResolvedPackage {
+	var winners []*ResolvedPackage
+	nameOrder := []string{}
+	candidateGroups := make(map[string][]*ResolvedPackage)
+	for _, candidate := range candidates {
+		packageName := candidate.PackageInfo.Name
+		group, exists := candidateGroups[packageName]
+		if !exists {
+			nameOrder = append(nameOrder, packageName)
+		}
+		candidateGroups[packageName] = append(group, candidate)
+	}
+
+	for _, packageName := range nameOrder {
+		group := candidateGroups[packageName]
+		resolved, exists := resolution.Resolved[packageName]
+		if exists {
+			//Already resolved closer to the project ("direct dependency wins" & "nearest wins")
+			eliminateCandidates(resolution, resolved, group)
+			continue
+		}
+
+		winner := pickCousinWinner(resolution, group)
+		resolution.Resolved[packageName] = winner
+		winners = append(winners, winner)
+	}
+	return winners
+}
+
+func eliminateCandidates(resolution *ProjectResolution, resolved *ResolvedPackage, candidates []*ResolvedPackage) {
+	for _, candidate := range candidates {
+		packageInfo := candidate.PackageInfo
+		if packageInfo == resolved.PackageInfo || !resolution.AddEliminated(candidate) {
+			continue
+		}
+
+		if utils.IsVersionHigher(packageInfo.Version, resolved.PackageInfo.Version) {
+			downgrade := &models.VersionDowngrade{
+				Resolved: resolved,
+				Required: candidate,
+			}
+			resolution.Downgrades = append(resolution.Downgrades, downgrade)
+		}
+	}
+}
+
+// Picks the lowest candidate version satisfying the range required by every cousin, or the highest one if none does.
+// Cousins disagreeing on the version are recorded as a conflict
+func pickCousinWinner(resolution *ProjectResolution, candidates []*ResolvedPackage) *ResolvedPackage {
+	winner := findLowestSatisfyingCandidate(resolution.Project, candidates)
+	if winner == nil {
+		winner = candidates[0]
+		for _, candidate := range candidates[1:] {
+			if utils.IsVersionHigher(candidate.PackageInfo.Version, winner.PackageInfo.Version) {
+				winner = candidate
+			}
+		}
+	}
+
+	var conflictCandidates []*ResolvedPackage
+	var unsatisfiedCandidates []*ResolvedPackage
+	seenPackages := utils.NewSet[*PackageInfo]()
+	for _, candidate := range candidates {
+		if candidate.PackageInfo != winner.PackageInfo {
+			resolution.AddEliminated(candidate)
+		}
+		if !getRequiredRange(resolution.Project, candidate).Satisfies(winner.PackageInfo.Version) {
+			unsatisfiedCandidates = append(unsatisfiedCandidates, candidate)
+		}
+		if seenPackages.Add(candidate.PackageInfo) {
+			conflictCandidates = append(conflictCandidates, candidate)
+		}
+	}
+
+	if len(conflictCandidates) > 1 {
+		conflict := &models.VersionConflict{
+			Chosen:      winner,
+			Candidates:  conflictCandidates,
+			Unsatisfied: unsatisfiedCandidates,
+		}
+		resolution.Conflicts = append(resolution.Conflicts, conflict)
+	}
+	return winner
+}
+
+// Returns the candidate with the lowest version satisfying every required range, or nil if there is none
+func findLowestSatisfyingCandidate(project *PackageInfo, candidates []*ResolvedPackage) *ResolvedPackage {
+	var lowestCandidate *ResolvedPackage
+	for _, candidate := range candidates {
+		version := candidate.PackageInfo.Version
+		if lowestCandidate != nil && !utils.IsVersionHigher(lowestCandidate.PackageInfo.Version, version) {
+			continue
+		}
+
+		unsatisfiedIndex := utils.IndexOf(candidates, 0, func(cousin *ResolvedPackage) bool {
+			return !getRequiredRange(project, cousin).Satisfies(version)
+		})
+		if unsatisfiedIndex == -1 {
+			lowestCandidate = candidate
+		}
+	}
+	return lowestCandidate
+}
+
+// Returns the version range the candidate's parent requires (the project itself for direct dependencies)
+func getRequiredRange(project *PackageInfo, candidate *ResolvedPackage) *VersionRange {
+	parent := project
+	if candidate.Depth > 1 {
+		parent = candidate.Path[candidate.Depth-2]
+	}
+	return parent.GetDependencyRange(candidate.PackageInfo)
+}
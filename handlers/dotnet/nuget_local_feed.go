@@ -0,0 +1,242 @@
+package dotnet
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// NuGetLocalFeed looks up packages inside a local folder feed.
+//
+// Supported layouts:
+//   - Hierarchical: "<feed>/<id>/<version>/<id>.nuspec" or "<feed>/<id>/<version>/<id>.<version>.nupkg"
+//   - packages.config: "<feed>/<id>.<version>/<id>.nuspec" or "<feed>/<id>.<version>/<id>.<version>.nupkg"
+//   - Flat: "<feed>/<id>.<version>.nupkg"
+//
+// NOTE: File names are matched case-insensitively, as feeds are often copied over from Windows machines.
+type NuGetLocalFeed struct {
+	FolderPath   string
+	entriesCache map[string]map[string]string //FolderPath => (LowerEntryName => EntryName)
+}
+
+func NewNuGetLocalFeed(folderPath string) *NuGetLocalFeed {
+	return &NuGetLocalFeed{
+		FolderPath:   filepath.Clean(folderPath),
+		entriesCache: make(map[string]map[string]string),
+	}
+}
+
+// Returns the path of the ".nuspec" or ".nupkg" file for the package (or "" if the feed does not contain it)
+func (localFeed *NuGetLocalFeed) FindPackageFile(packageName string, version string) string {
+	packageId := packageName + "." + version
+	nuspecName := packageName + ".nuspec"
+	nupkgName := packageId + ".nupkg"
+
+	hierarchicalPath := localFeed.findFilePath(localFeed.FolderPath, packageName, version)
+	if hierarchicalPath != "" {
+		filePath := localFeed.findFirstFile(hierarchicalPath, nuspecName, nupkgName)
+		if filePath != "" {
+			return filePath
+		}
+	}
+
+	packagesConfigPath := localFeed.findFilePath(localFeed.FolderPath, packageId)
+	if packagesConfigPath != "" {
+		filePath := localFeed.findFirstFile(packagesConfigPath, nuspecName, nupkgName)
+		if filePath != "" {
+			return filePath
+		}
+	}
+
+	flatPath := localFeed.findFilePath(localFeed.FolderPath, nupkgName)
+	return flatPath
+}
+
+func (localFeed *NuGetLocalFeed) findFirstFile(folderPath string, fileNames ...string) string {
+	for _, fileName := range fileNames {
+		filePath := localFeed.findFilePath(folderPath, fileName)
+		if filePath != "" {
+			return filePath
+		}
+	}
+	return ""
+}
+
+// Resolves each path segment (case-insensitively) starting from "folderPath". Returns "" if any segment is missing
+func (localFeed *NuGetLocalFeed) findFilePath(folderPath string, segments ...string) string {
+	currentPath := folderPath
+	for _, segment := range segments {
+		entries := localFeed.getEntries(currentPath)
+		entryName, exists := entries[strings.ToLower(segment)]
+		if !exists {
+			return ""
+		}
+		currentPath = filepath.Join(currentPath, entryName)
+	}
+	return currentPath
+}
+
+func (localFeed *NuGetLocalFeed) getEntries(folderPath string) map[string]string {
+	entries, exists := localFeed.entriesCache[folderPath]
+	if exists {
+		return entries
+	}
+
+	entries = make(map[string]string)
+	dirEntries, err := os.ReadDir(folderPath)
+	if err == nil {
+		for _, dirEntry := range dirEntries {
+			entryName := dirEntry.Name()
+			entries[strings.ToLower(entryName)] = entryName
+		}
+	}
+	localFeed.entriesCache[folderPath] = entries
+	return entries
+}
+
+// Reads a package spec from either a ".nuspec" file or the ".nuspec" embedded in a ".nupkg" archive
+func readPackageSpecFile(filePath string) (*etree.Document, error) {
+	if strings.EqualFold(filepath.Ext(filePath), ".nupkg") {
+		return readNupkgSpec(filePath)
+	}
+
+	document := etree.NewDocument()
+	err := document.ReadFromFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+func readNupkgSpec(nupkgPath string) (*etree.Document, error) {
+	archive, err := zip.OpenReader(nupkgPath)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	for _, entry := range archive.File {
+		isRootEntry := !strings.Contains(entry.Name, "/")
+		if isRootEntry && strings.EqualFold(filepath.Ext(entry.Name), ".nuspec") {
+			return readZipEntry(entry)
+		}
+	}
+	return nil, fmt.Errorf(`no ".nuspec" file found in archive: %s`, nupkgPath)
+}
+
+func readZipEntry(entry *zip.File) (*etree.Document, error) {
+	reader, err := entry.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	document := etree.NewDocument()
+	err = document.ReadFromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// Returns the target frameworks of the package's "lib/<tfm>/" folders (either extracted next to the ".nuspec" or inside the ".nupkg")
+func readPackageLibFrameworks(filePath string) []string {
+	if strings.EqualFold(filepath.Ext(filePath), ".nupkg") {
+		return readNupkgLibFrameworks(filePath)
+	}
+
+	frameworks := []string{}
+	entries, err := os.ReadDir(filepath.Join(filepath.Dir(filePath), "lib"))
+	if err != nil {
+		return frameworks
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			frameworks = append(frameworks, entry.Name())
+		}
+	}
+	return frameworks
+}
+
+func readNupkgLibFrameworks(nupkgPath string) []string {
+	frameworks := []string{}
+	archive, err := zip.OpenReader(nupkgPath)
+	if err != nil {
+		return frameworks
+	}
+	defer archive.Close()
+
+	seenFrameworks := make(map[string]bool)
+	for _, entry := range archive.File {
+		segments := strings.Split(entry.Name, "/")
+		if len(segments) < 3 || !strings.EqualFold(segments[0], "lib") || seenFrameworks[segments[1]] {
+			continue
+		}
+		seenFrameworks[segments[1]] = true
+		frameworks = append(frameworks, segments[1])
+	}
+	return frameworks
+}
+
+// Returns the assembly names (without the ".dll" extension) found in the package's "lib/<tfm>/" folders, for every target framework
+func readPackageLibAssemblies(filePath string) []string {
+	if strings.EqualFold(filepath.Ext(filePath), ".nupkg") {
+		return readNupkgLibAssemblies(filePath)
+	}
+
+	assemblyNames := []string{}
+	assemblyPaths, err := filepath.Glob(filepath.Join(filepath.Dir(filePath), "lib", "*", "*"))
+	if err != nil {
+		return assemblyNames
+	}
+	for _, assemblyPath := range assemblyPaths {
+		assemblyName, isAssembly := trimAssemblyExtension(filepath.Base(assemblyPath))
+		if isAssembly {
+			assemblyNames = append(assemblyNames, assemblyName)
+		}
+	}
+	sort.Strings(assemblyNames)
+	return slices.Compact(assemblyNames)
+}
+
+func readNupkgLibAssemblies(nupkgPath string) []string {
+	assemblyNames := []string{}
+	archive, err := zip.OpenReader(nupkgPath)
+	if err != nil {
+		return assemblyNames
+	}
+	defer archive.Close()
+
+	for _, entry := range archive.File {
+		segments := strings.Split(entry.Name, "/")
+		if len(segments) != 3 || !strings.EqualFold(segments[0], "lib") {
+			continue
+		}
+		assemblyName, isAssembly := trimAssemblyExtension(segments[2])
+		if isAssembly {
+			assemblyNames = append(assemblyNames, assemblyName)
+		}
+	}
+	sort.Strings(assemblyNames)
+	return slices.Compact(assemblyNames)
+}
+
+func trimAssemblyExtension(fileName string) (string, bool) {
+	extension := filepath.Ext(fileName)
+	if !strings.EqualFold(extension, ".dll") {
+		return "", false
+	}
+	return strings.TrimSuffix(fileName, extension), true
+}
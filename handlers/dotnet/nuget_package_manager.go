@@ -2,9 +2,13 @@ package dotnet
 
 import (
 	"fmt"
+	"io/fs"
 	"log"
+	"os"
 	"path/filepath"
+	"redun-pendancy/models"
 	"redun-pendancy/utils"
+	"slices"
 	"sort"
 	"strings"
 
@@ -14,6 +18,7 @@ import (
 type DotNetPackageManager struct {
 	packageContainer  *PackageContainer
 	nugetPackagesPath string
+	localFeeds        []*NuGetLocalFeed
 }
 
 func NewNuGetPackageManager(userHomePath string, packageContainer *PackageContainer) *DotNetPackageManager {
@@ -23,63 +28,121 @@ func NewNuGetPackageManager(userHomePath string, packageContainer *PackageContai
 	}
 }
 
+// Registers a local folder feed, searched (in registration order) when a package is missing from the NuGet cache
+func (packageManager *DotNetPackageManager) AddLocalFeed(folderPath string) bool {
+	folderPath = filepath.Clean(folderPath)
+	for _, localFeed := range packageManager.localFeeds {
+		if localFeed.FolderPath == folderPath {
+			return false
+		}
+	}
+	packageManager.localFeeds = append(packageManager.localFeeds, NewNuGetLocalFeed(folderPath))
+	return true
+}
+
+func (packageManager *DotNetPackageManager) GetLocalFeedPaths() []string {
+	return utils.Map(packageManager.localFeeds, func(localFeed *NuGetLocalFeed) string {
+		return localFeed.FolderPath
+	})
+}
+
 func (packageManager *DotNetPackageManager) FetchDependencies(packageInfo *PackageInfo, rootFramework string) error {
-	packageNameLower := strings.ToLower(packageInfo.Name)
+	packageSpecPath, err := packageManager.findPackageSpec(packageInfo.Name, packageInfo.Version)
+	if err != nil {
+		return err
+	}
+
+	packageInfo.FilePath = packageSpecPath
+	err = packageManager.load(packageInfo, packageSpecPath, rootFramework)
+	return err
+}
+
+// Returns the path of the ".nuspec" (or ".nupkg") file, looking in the NuGet cache first and then in the local feeds
+func (packageManager *DotNetPackageManager) findPackageSpec(packageName string, version string) (string, error) {
+	packageNameLower := strings.ToLower(packageName)
 	packageSpecPath := filepath.Join(
 		packageManager.nugetPackagesPath,
 		packageNameLower,
-		packageInfo.Version,
+		version,
 		packageNameLower+".nuspec",
 	)
 
-	packageInfo.FilePath = packageSpecPath
-	err := packageManager.load(packageInfo, packageSpecPath, rootFramework)
-	return err
+	_, err := os.Stat(packageSpecPath)
+	if err == nil {
+		return packageSpecPath, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	for _, localFeed := range packageManager.localFeeds {
+		packageFilePath := localFeed.FindPackageFile(packageName, version)
+		if packageFilePath != "" {
+			return packageFilePath, nil
+		}
+	}
+	return "", fmt.Errorf(`package "%s" (%s) not found in the NuGet cache or local feeds: %w`, packageName, version, fs.ErrNotExist)
 }
 
 func (packageManager *DotNetPackageManager) load(packageInfo *PackageInfo, packageSpecPath string, rootFramework string) error {
-	document := etree.NewDocument()
-	err := document.ReadFromFile(packageSpecPath)
+	document, err := readPackageSpecFile(packageSpecPath)
 	if err != nil {
 		return err
 	}
 
+	readLicense(document, packageInfo)
 	if isToolPackage(document) {
 		//Package is a tool. Let's mark it as such without checking its dependencies
 		packageInfo.MarkAsTool()
 		return nil
 	}
 
+	dependencyGroup, framework, found, err := selectDependencyGroup(document, packageInfo.Framework, rootFramework)
+	if err != nil {
+		return err
+	}
+	if !found {
+		log.Printf(`[Warning] Could not resolve dependencies for package "%s"`, packageInfo.ToString())
+		return nil
+	}
+	if dependencyGroup != nil {
+		packageManager.processDependencies(dependencyGroup, packageInfo, framework)
+	}
+	return nil
+}
+
+// Returns the node (either "<dependencies>" or one of its framework "<group>"s) with the dependencies that apply to the root framework.
+//
+// NOTE: A nil node (with "found" set) means the package has no dependencies.
+func selectDependencyGroup(document *etree.Document, packageFramework string, rootFramework string) (*etree.Element, string, bool, error) {
 	dependencies := document.FindElement("//package/metadata/dependencies")
 	if dependencies == nil || len(dependencies.Child) == 0 {
 		//Package has no dependencies, nothing to do
-		return nil
+		return nil, "", true, nil
 	}
 
 	frameworkGroups := extractFrameworkGroups(dependencies)
 	if len(frameworkGroups) == 0 {
-		packageManager.processDependencies(dependencies, packageInfo, packageInfo.Framework)
-		return nil
+		return dependencies, packageFramework, true, nil
 	}
 
-	handled, rootFamily, err := packageManager.loadWithFrameworkOrFamily(frameworkGroups, packageInfo, rootFramework)
+	framework, rootFamily, err := selectFrameworkOrFamily(frameworkGroups, rootFramework)
 	if err != nil {
-		return err
+		return nil, "", false, err
 	}
-	if handled {
-		return nil
+	if framework != "" {
+		return frameworkGroups[framework], framework, true, nil
 	}
 
 	var packageFamily *DotNetFrameworkFamily
-	framework := packageInfo.Framework
-	if !rootFamily.ContainsFramework(framework) {
+	if !rootFamily.ContainsFramework(packageFramework) {
 		//Package framework family differs from root framework family
-		handled, packageFamily, err = packageManager.loadWithFrameworkOrFamily(frameworkGroups, packageInfo, framework)
+		framework, packageFamily, err = selectFrameworkOrFamily(frameworkGroups, packageFramework)
 		if err != nil {
-			return err
+			return nil, "", false, err
 		}
-		if handled {
-			return nil
+		if framework != "" {
+			return frameworkGroups[framework], framework, true, nil
 		}
 	} else {
 		packageFamily = rootFamily
@@ -88,13 +151,146 @@ func (packageManager *DotNetPackageManager) load(packageInfo *PackageInfo, packa
 	// Fallback to ".NETStandard" (if the frameworks used weren't already ".NETStandard")
 	netstandardFamily := &frameworkFamilies[2]
 	if rootFamily != netstandardFamily && packageFamily != netstandardFamily {
-		if packageManager.tryLoadWithFamilyFramework(frameworkGroups, packageInfo, netstandardFamily) {
-			return nil
+		framework = selectFamilyFramework(frameworkGroups, netstandardFamily)
+		if framework != "" {
+			return frameworkGroups[framework], framework, true, nil
 		}
 	}
+	return nil, "", false, nil
+}
 
-	log.Printf(`[Warning] Could not resolve dependencies for package "%s"`, packageInfo.ToString())
-	return nil
+// Returns the versions of the package found in the NuGet cache (sorted by ascending version)
+func (packageManager *DotNetPackageManager) GetAvailableVersions(packageName string) []string {
+	packageFolderPath := filepath.Join(packageManager.nugetPackagesPath, strings.ToLower(packageName))
+	entries, err := os.ReadDir(packageFolderPath)
+	if err != nil {
+		return nil
+	}
+
+	versions := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return utils.IsVersionHigher(versions[j], versions[i])
+	})
+	return versions
+}
+
+// Reads the dependencies (PackageName => Version) of a package version straight from its ".nuspec", without registering any package
+func (packageManager *DotNetPackageManager) ReadDependencyVersions(packageName string, version string, rootFramework string) (map[string]string, error) {
+	packageSpecPath, err := packageManager.findPackageSpec(packageName, version)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := readPackageSpecFile(packageSpecPath)
+	if err != nil {
+		return nil, err
+	}
+
+	dependencyVersions := make(map[string]string)
+	dependencyGroup, _, _, err := selectDependencyGroup(document, rootFramework, rootFramework)
+	if err != nil || dependencyGroup == nil {
+		return dependencyVersions, err
+	}
+
+	for _, dependencyNode := range dependencyGroup.SelectElements("dependency") {
+		dependencyName := dependencyNode.SelectAttrValue("id", "")
+		dependencyVersion := dependencyNode.SelectAttrValue("version", "")
+		if dependencyName != "" && dependencyVersion != "" {
+			dependencyVersions[dependencyName] = parsePreferredVersion(dependencyVersion)
+		}
+	}
+	return dependencyVersions, nil
+}
+
+// Returns the target frameworks supported by a package version (from its dependency groups and "lib" folders)
+func (packageManager *DotNetPackageManager) GetSupportedFrameworks(packageName string, version string) []string {
+	packageSpecPath, err := packageManager.findPackageSpec(packageName, version)
+	if err != nil {
+		return nil
+	}
+
+	document, err := readPackageSpecFile(packageSpecPath)
+	if err != nil {
+		return nil
+	}
+
+	frameworks := []string{}
+	dependencies := document.FindElement("//package/metadata/dependencies")
+	if dependencies != nil {
+		frameworks = append(frameworks, utils.GetMapKeys(extractFrameworkGroups(dependencies))...)
+	}
+	frameworks = append(frameworks, readPackageLibFrameworks(packageSpecPath)...)
+	sort.Strings(frameworks)
+	return slices.Compact(frameworks)
+}
+
+// Returns the names of the assemblies shipped by a package version (from its "lib" folders)
+func (packageManager *DotNetPackageManager) GetAssemblyNames(packageName string, version string) []string {
+	packageSpecPath, err := packageManager.findPackageSpec(packageName, version)
+	if err != nil {
+		return nil
+	}
+	return readPackageLibAssemblies(packageSpecPath)
+}
+
+func (packageManager *DotNetPackageManager) CheckFrameworkCompatibility(framework string, targetFramework string) models.FrameworkCompatibility {
+	return checkFrameworkCompatibility(framework, targetFramework)
+}
+
+// Returns the shared framework (eg: "Microsoft.NETCore.App") of the project that already provides the package dependency, or "" if none does
+func (packageManager *DotNetPackageManager) FindSharedFramework(project *PackageInfo, dependency *PackageInfo) string {
+	return findSharedFramework(project, dependency)
+}
+
+// Returns the end of support date ("YYYY-MM-DD") of the target framework, or "" if unknown or not scheduled
+func (packageManager *DotNetPackageManager) GetFrameworkEndOfSupport(framework string) string {
+	return getFrameworkEndOfSupport(framework)
+}
+
+// Returns the latest Long Term Support framework of the same family as the target framework, or "" if unknown
+func (packageManager *DotNetPackageManager) GetLatestLtsFramework(framework string) string {
+	return getLatestLtsFramework(framework)
+}
+
+// Returns the package references of the project that have no assets compatible with the target framework
+func (packageManager *DotNetPackageManager) FindIncompatiblePackages(project *PackageInfo, framework string) []*PackageInfo {
+	incompatiblePackages := []*PackageInfo{}
+	for _, dependency := range project.Dependencies {
+		if dependency.IsProject() || dependency.IsTool() {
+			continue
+		}
+
+		frameworks := packageManager.GetSupportedFrameworks(dependency.Name, dependency.Version)
+		_, isCompatible := utils.FirstOrDefault(frameworks, func(packageFramework string) bool {
+			//Unknown frameworks get the benefit of the doubt & fallback assets were already used that way
+			return checkFrameworkCompatibility(framework, packageFramework) != models.FrameworkCompatibility_Incompatible
+		})
+		if len(frameworks) != 0 && !isCompatible {
+			incompatiblePackages = append(incompatiblePackages, dependency)
+		}
+	}
+	return incompatiblePackages
+}
+
+func readLicense(document *etree.Document, packageInfo *PackageInfo) {
+	license := document.FindElement("//package/metadata/license")
+	if license != nil {
+		licenseText := strings.TrimSpace(license.Text())
+		if license.SelectAttrValue("type", "") == "file" {
+			licenseText = "file:" + licenseText
+		}
+		packageInfo.License = licenseText
+	}
+
+	licenseUrl := document.FindElement("//package/metadata/licenseUrl")
+	if licenseUrl != nil {
+		packageInfo.LicenseUrl = strings.TrimSpace(licenseUrl.Text())
+	}
 }
 
 func isToolPackage(document *etree.Document) bool {
@@ -109,28 +305,29 @@ func isToolPackage(document *etree.Document) bool {
 
 func (packageManager *DotNetPackageManager) processDependencies(parentNode *etree.Element, packageInfo *PackageInfo, framework string) {
 	for _, dependencyNode := range parentNode.SelectElements("dependency") {
-		dependency := packageManager.processDependency(dependencyNode, framework)
+		dependency, versionRange := packageManager.processDependency(dependencyNode, framework)
 		if dependency != nil {
 			packageInfo.AddDependency(dependency)
+			packageInfo.SetDependencyRange(dependency.Name, versionRange)
 		}
 	}
 }
 
-func (packageManager *DotNetPackageManager) loadWithFrameworkOrFamily(frameworkGroups map[string]*etree.Element, packageInfo *PackageInfo, framework string) (bool, *DotNetFrameworkFamily, error) {
-	if packageManager.loadWithFramework(frameworkGroups, packageInfo, framework) {
+// Returns the exact framework group (if any), otherwise the highest framework group of the same family (if any)
+func selectFrameworkOrFamily(frameworkGroups map[string]*etree.Element, framework string) (string, *DotNetFrameworkFamily, error) {
+	_, exists := frameworkGroups[framework]
+	if exists {
 		//Handled with exact matching framework
-		return true, nil, nil
+		return framework, nil, nil
 	}
 
 	frameworkFamily := getFrameworkFamily(framework)
 	if frameworkFamily == nil {
-		return false, nil, fmt.Errorf("failed to determine target framework family of: %s", framework)
+		return "", nil, fmt.Errorf("failed to determine target framework family of: %s", framework)
 	}
 
-	if packageManager.tryLoadWithFamilyFramework(frameworkGroups, packageInfo, frameworkFamily) {
-		return true, frameworkFamily, nil
-	}
-	return false, frameworkFamily, nil
+	familyFramework := selectFamilyFramework(frameworkGroups, frameworkFamily)
+	return familyFramework, frameworkFamily, nil
 }
 
 func getFrameworkFamily(framework string) *DotNetFrameworkFamily {
@@ -153,33 +350,38 @@ func extractFrameworkGroups(dependencies *etree.Element) map[string]*etree.Eleme
 	return frameworkGroups
 }
 
-func (packageManager *DotNetPackageManager) loadWithFramework(frameworkGroups map[string]*etree.Element, packageInfo *PackageInfo, framework string) bool {
-	groupNode, exists := frameworkGroups[framework]
-	if !exists {
-		return false
-	}
-	packageManager.processDependencies(groupNode, packageInfo, framework)
-	return true
-}
-
-func (packageManager *DotNetPackageManager) processDependency(dependencyNode *etree.Element, framework string) *PackageInfo {
+func (packageManager *DotNetPackageManager) processDependency(dependencyNode *etree.Element, framework string) (*PackageInfo, *VersionRange) {
 	packageName := dependencyNode.SelectAttrValue("id", "")
 	if packageName == "" {
 		//Super unlikely to happen though
 		log.Print("[Warning] Skipped dependency node due to missing 'id' attribute.")
-		return nil
+		return nil, nil
 	}
 
 	version := dependencyNode.SelectAttrValue("version", "")
 	if version == "" {
 		//Super unlikely to happen though
 		log.Print("[Warning] Skipped dependency node due to missing 'version' attribute.")
-		return nil
+		return nil, nil
+	}
+
+	versionRange, err := models.ParseVersionRange(version)
+	if err != nil {
+		log.Printf("[Warning] Invalid version of dependency '%s': %v", packageName, err)
+		versionRange = models.NewMinimumVersionRange(normalizeVersion(version))
 	}
 
-	normalizedVersion := normalizeVersion(version)
+	normalizedVersion := versionRange.GetPreferredVersion()
 	dependency := packageManager.packageContainer.GetOrCreatePackage(packageName, normalizedVersion, framework, "NOT_LOADED")
-	return dependency
+	return dependency, versionRange
+}
+
+func parsePreferredVersion(version string) string {
+	versionRange, err := models.ParseVersionRange(version)
+	if err != nil {
+		return normalizeVersion(version)
+	}
+	return versionRange.GetPreferredVersion()
 }
 
 func normalizeVersion(version string) string {
@@ -191,14 +393,12 @@ func normalizeVersion(version string) string {
 	return utils.NormalizeVersion(version)
 }
 
-func (packageManager *DotNetPackageManager) tryLoadWithFamilyFramework(frameworkGroups map[string]*etree.Element, packageInfo *PackageInfo, frameworkFamily *DotNetFrameworkFamily) bool {
+func selectFamilyFramework(frameworkGroups map[string]*etree.Element, frameworkFamily *DotNetFrameworkFamily) string {
 	familyFrameworks := getSortedFamilyFrameworks(frameworkGroups, frameworkFamily)
-	for _, framework := range familyFrameworks {
-		if packageManager.loadWithFramework(frameworkGroups, packageInfo, framework) {
-			return true
-		}
+	if len(familyFrameworks) == 0 {
+		return ""
 	}
-	return false
+	return familyFrameworks[0]
 }
 
 func getSortedFamilyFrameworks(frameworkGroups map[string]*etree.Element, frameworkFamily *DotNetFrameworkFamily) []string {
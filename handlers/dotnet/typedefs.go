@@ -7,3 +7,8 @@ import (
 
 type PackageInfo = models.PackageInfo
 type PackageContainer = base.PackageContainer
+type Diagnostic = models.Diagnostic
+type ResolvedPackage = models.ResolvedPackage
+type ProjectResolution = models.ProjectResolution
+type VersionRange = models.VersionRange
+type ProjectReference = models.ProjectReference
@@ -0,0 +1,129 @@
+package helpers
+
+import (
+	"redun-pendancy/models"
+	"sort"
+)
+
+// DependencyCycleFinder finds every circular project reference.
+//
+// NOTE: The strongly connected components (Tarjan's algorithm) narrow down the projects involved in cycles,
+// then every elementary cycle of each component gets enumerated.
+type DependencyCycleFinder struct {
+	index    int
+	indexes  map[*models.PackageInfo]int
+	lowLinks map[*models.PackageInfo]int
+	stack    []*models.PackageInfo
+	onStack  map[*models.PackageInfo]bool
+	cycles   []*models.DependencyCycle
+}
+
+func FindDependencyCycles(projects []*models.PackageInfo) []*models.DependencyCycle {
+	finder := &DependencyCycleFinder{
+		indexes:  make(map[*models.PackageInfo]int),
+		lowLinks: make(map[*models.PackageInfo]int),
+		onStack:  make(map[*models.PackageInfo]bool),
+	}
+
+	sortedProjects := sortProjectsByName(projects)
+	for _, project := range sortedProjects {
+		_, visited := finder.indexes[project]
+		if !visited {
+			finder.visit(project)
+		}
+	}
+	return finder.cycles
+}
+
+func (finder *DependencyCycleFinder) visit(project *models.PackageInfo) {
+	finder.indexes[project] = finder.index
+	finder.lowLinks[project] = finder.index
+	finder.index++
+	finder.stack = append(finder.stack, project)
+	finder.onStack[project] = true
+
+	for _, reference := range getProjectReferences(project) {
+		_, visited := finder.indexes[reference]
+		if !visited {
+			finder.visit(reference)
+			finder.lowLinks[project] = min(finder.lowLinks[project], finder.lowLinks[reference])
+		} else if finder.onStack[reference] {
+			finder.lowLinks[project] = min(finder.lowLinks[project], finder.indexes[reference])
+		}
+	}
+
+	if finder.lowLinks[project] != finder.indexes[project] {
+		//Not the root of a component
+		return
+	}
+
+	var component []*models.PackageInfo
+	for {
+		lastIndex := len(finder.stack) - 1
+		member := finder.stack[lastIndex]
+		finder.stack = finder.stack[:lastIndex]
+		finder.onStack[member] = false
+		component = append(component, member)
+		if member == project {
+			break
+		}
+	}
+	finder.cycles = append(finder.cycles, findComponentCycles(component)...)
+}
+
+// Enumerates the elementary cycles of a strongly connected component. Each cycle starts at its "lowest" project (by name)
+func findComponentCycles(component []*models.PackageInfo) []*models.DependencyCycle {
+	var cycles []*models.DependencyCycle
+	component = sortProjectsByName(component)
+	allowedProjects := make(map[*models.PackageInfo]bool)
+	for _, project := range component {
+		allowedProjects[project] = true
+	}
+
+	for _, startProject := range component {
+		path := []*models.PackageInfo{startProject}
+		onPath := map[*models.PackageInfo]bool{startProject: true}
+		walkComponentCycles(startProject, startProject, path, onPath, allowedProjects, &cycles)
+		//Cycles through "startProject" are all found, no need to go through it anymore
+		allowedProjects[startProject] = false
+	}
+	return cycles
+}
+
+func walkComponentCycles(startProject *models.PackageInfo, project *models.PackageInfo, path []*models.PackageInfo, onPath map[*models.PackageInfo]bool, allowedProjects map[*models.PackageInfo]bool, cycles *[]*models.DependencyCycle) {
+	for _, reference := range getProjectReferences(project) {
+		if reference == startProject {
+			cyclePath := make([]*models.PackageInfo, len(path))
+			copy(cyclePath, path)
+			*cycles = append(*cycles, models.NewDependencyCycle(cyclePath))
+			continue
+		}
+
+		if !allowedProjects[reference] || onPath[reference] {
+			continue
+		}
+
+		onPath[reference] = true
+		walkComponentCycles(startProject, reference, append(path, reference), onPath, allowedProjects, cycles)
+		onPath[reference] = false
+	}
+}
+
+func getProjectReferences(project *models.PackageInfo) []*models.PackageInfo {
+	var references []*models.PackageInfo
+	for _, dependency := range project.Dependencies {
+		if dependency.IsProject() {
+			references = append(references, dependency)
+		}
+	}
+	return sortProjectsByName(references)
+}
+
+func sortProjectsByName(projects []*models.PackageInfo) []*models.PackageInfo {
+	sortedProjects := make([]*models.PackageInfo, len(projects))
+	copy(sortedProjects, projects)
+	sort.Slice(sortedProjects, func(i, j int) bool {
+		return sortedProjects[i].Name < sortedProjects[j].Name
+	})
+	return sortedProjects
+}
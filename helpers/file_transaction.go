@@ -0,0 +1,106 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// FileTransaction writes several files as a whole: every file is first written to a temporary file next to it,
+// then all of them get renamed over their targets. Targets already replaced are restored if a rename fails.
+//
+// NOTE: Files are replaced in the order they were staged.
+type FileTransaction struct {
+	stagedFiles []stagedFile
+}
+
+type stagedFile struct {
+	filePath    string
+	tempPath    string
+	backup      []byte
+	fileExisted bool
+}
+
+func NewFileTransaction() *FileTransaction {
+	return &FileTransaction{}
+}
+
+// Writes the content to a temporary file, to be renamed over "filePath" by "Commit()"
+func (transaction *FileTransaction) Stage(filePath string, content []byte) error {
+	tempFile, err := os.CreateTemp(filepath.Dir(filePath), "."+filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(content)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = copyFileMode(filePath, tempFile.Name())
+	}
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+
+	transaction.stagedFiles = append(transaction.stagedFiles, stagedFile{
+		filePath: filePath,
+		tempPath: tempFile.Name(),
+	})
+	return nil
+}
+
+// Replaces every target with its staged file. On failure, the targets already replaced get their previous content back
+func (transaction *FileTransaction) Commit() error {
+	for index := range transaction.stagedFiles {
+		stagedFile := &transaction.stagedFiles[index]
+		backup, err := os.ReadFile(stagedFile.filePath)
+		if err != nil && !os.IsNotExist(err) {
+			transaction.rollback(index)
+			return err
+		}
+		stagedFile.backup = backup
+		stagedFile.fileExisted = err == nil
+
+		err = os.Rename(stagedFile.tempPath, stagedFile.filePath)
+		if err != nil {
+			transaction.rollback(index)
+			return err
+		}
+	}
+	transaction.stagedFiles = nil
+	return nil
+}
+
+// Removes the staged (not yet committed) temporary files
+func (transaction *FileTransaction) Discard() {
+	for _, stagedFile := range transaction.stagedFiles {
+		os.Remove(stagedFile.tempPath)
+	}
+	transaction.stagedFiles = nil
+}
+
+// Restores the targets replaced before "failedIndex" and discards the remaining temporary files
+func (transaction *FileTransaction) rollback(failedIndex int) {
+	for index := failedIndex - 1; index >= 0; index-- {
+		stagedFile := transaction.stagedFiles[index]
+		if stagedFile.fileExisted {
+			os.WriteFile(stagedFile.filePath, stagedFile.backup, 0644)
+		} else {
+			os.Remove(stagedFile.filePath)
+		}
+	}
+	transaction.stagedFiles = transaction.stagedFiles[failedIndex:]
+	transaction.Discard()
+}
+
+// Keeps the permissions of the existing file (temporary files are created with 0600)
+func copyFileMode(sourcePath string, targetPath string) error {
+	mode := os.FileMode(0644)
+	fileInfo, err := os.Stat(sourcePath)
+	if err == nil {
+		mode = fileInfo.Mode().Perm()
+	}
+	return os.Chmod(targetPath, mode)
+}
@@ -9,7 +9,9 @@ import (
 	"sort"
 	"strings"
 
+	"redun-pendancy/analysis"
 	"redun-pendancy/analysis/analyzers"
+	"redun-pendancy/config"
 	"redun-pendancy/gui"
 	"redun-pendancy/handlers/dotnet"
 	"redun-pendancy/helpers"
@@ -35,6 +37,7 @@ type MainWindow struct {
 	userHomePath string
 
 	projectHandler ProjectHandler
+	config         *config.Config
 
 	projectActions      []ProjectAction
 	analysisSuggestions string
@@ -55,8 +58,9 @@ type MainWindowUI struct {
 	dependencyFramework *widget.Label
 	totalReferences     *widget.Label
 
-	analyzeButton    *widget.Button
-	openReportButton *widget.Button
+	analyzeButton        *widget.Button
+	openReportButton     *widget.Button
+	exportLicensesButton *widget.Button
 
 	analysisResultsContainer *fyne.Container
 	analysisSuggestions      *widget.Entry
@@ -90,8 +94,9 @@ func NewMainWindow(application fyne.App, userHomePath string) *MainWindow {
 		dependencyFramework: widget.NewLabel("Framework:"),
 		totalReferences:     widget.NewLabel("Total References:"),
 
-		analyzeButton:    widget.NewButton("Analyze", mainWindow.analyzeButton_Click),
-		openReportButton: widget.NewButton("Open report", mainWindow.openReportButton_Click),
+		analyzeButton:        widget.NewButton("Analyze", mainWindow.analyzeButton_Click),
+		openReportButton:     widget.NewButton("Open report", mainWindow.openReportButton_Click),
+		exportLicensesButton: widget.NewButton("Export licenses", mainWindow.exportLicensesButton_Click),
 
 		analysisSuggestions: widget.NewMultiLineEntry(),
 		actionsContainer:    container.NewVBox(),
@@ -154,6 +159,7 @@ func createMainContainer(mainWindow *MainWindow, ui *MainWindowUI) *fyne.Contain
 		dependencyViewSplit,
 		ui.analyzeButton,
 		ui.openReportButton,
+		ui.exportLicensesButton,
 	)
 
 	mainContainer := container.NewBorder(
@@ -203,6 +209,7 @@ func (mainWindow *MainWindow) initUI() {
 	window := mainWindow.window
 
 	ui.openReportButton.Hide()
+	ui.exportLicensesButton.Hide()
 	ui.analysisResultsContainer.Hide()
 
 	ui.searchEntry.OnSubmitted = mainWindow.searchEntry_Submit
@@ -244,9 +251,15 @@ func (mainWindow *MainWindow) loadFile(filePath string) {
 		return
 	}
 
+	analysisConfig, err := config.Load(filepath.Dir(filePath))
+	if err != nil {
+		mainWindow.showError(err)
+		return
+	}
+
 	log.Println("Loading:", filePath)
 	mainWindow.setDropFileLabelText("Loading project...")
-	err := projectHandler.Initialize(filePath)
+	err = projectHandler.Initialize(filePath)
 	if err != nil {
 		mainWindow.setDropFileLabelText("Drop a project file here")
 		mainWindow.showError(err)
@@ -255,7 +268,28 @@ func (mainWindow *MainWindow) loadFile(filePath string) {
 
 	log.Println("Project loaded successfully!")
 	mainWindow.projectHandler = projectHandler
+	mainWindow.config = analysisConfig
 	mainWindow.refreshProjectView()
+
+	diagnostics := projectHandler.GetDiagnostics()
+	if len(diagnostics) != 0 {
+		mainWindow.showDiagnostics(diagnostics)
+	}
+}
+
+func (mainWindow *MainWindow) showDiagnostics(diagnostics []*models.Diagnostic) {
+	diagnosticList := container.NewVBox()
+	for _, diagnostic := range diagnostics {
+		label := widget.NewLabel("• " + diagnostic.ToString())
+		label.Wrapping = fyne.TextWrapWord
+		diagnosticList.Add(label)
+	}
+
+	title := fmt.Sprintf("Loaded with %d warning(s)", len(diagnostics))
+	scrollableContent := container.NewScroll(diagnosticList)
+	customDialog := dialog.NewCustom(title, "Close", scrollableContent, mainWindow.window)
+	customDialog.Resize(fyne.NewSize(700, 400))
+	customDialog.Show()
 }
 
 func (mainWindow *MainWindow) getProjectHandler(filePath string) ProjectHandler {
@@ -340,7 +374,7 @@ func (mainWindow *MainWindow) dependencyTree_OnSelected(packageInfo *models.Pack
 
 func (mainWindow *MainWindow) analyzeButton_Click() {
 	ui := mainWindow.ui
-	results := analyzers.AnalyzeProject(mainWindow.projectHandler)
+	results := analyzers.AnalyzeProject(mainWindow.projectHandler, mainWindow.config)
 	actions := results.GetActions()
 	mainWindow.projectActions = actions
 
@@ -369,6 +403,7 @@ func (mainWindow *MainWindow) analyzeButton_Click() {
 
 	ui.analyzeButton.Hide()
 	ui.openReportButton.Show()
+	ui.exportLicensesButton.Show()
 	ui.analysisResultsContainer.Show()
 	ui.actionsScrollContainer.ScrollToTop()
 	mainWindow.selectedActions = selectedActions
@@ -435,6 +470,32 @@ func (mainWindow *MainWindow) openReportButton_Click() {
 	customDialog.Show()
 }
 
+func (mainWindow *MainWindow) exportLicensesButton_Click() {
+	saveDialog := dialog.NewFileSave(mainWindow.saveLicenseInventory, mainWindow.window)
+	saveDialog.SetFileName("licenses.csv")
+	saveDialog.Show()
+}
+
+func (mainWindow *MainWindow) saveLicenseInventory(writer fyne.URIWriteCloser, err error) {
+	if err != nil {
+		mainWindow.showError(err)
+		return
+	}
+	if writer == nil {
+		//Cancelled
+		return
+	}
+	defer writer.Close()
+
+	inventory := analysis.BuildLicenseInventory(mainWindow.projectHandler)
+	err = analysis.WriteLicenseInventory(writer, inventory)
+	if err != nil {
+		mainWindow.showError(err)
+		return
+	}
+	log.Printf("License inventory exported to %s", writer.URI().Path())
+}
+
 func createDependencyList(dependenciesCount *helpers.HashBag[string]) fyne.CanvasObject {
 	dependencyList := container.NewVBox()
 	sortedDependencies := dependenciesCount.GetSortedEntriesDesc()
@@ -0,0 +1,36 @@
+package models
+
+import "strings"
+
+// DependencyCycle is a chain of projects referencing each other, where the last project references the first one
+type DependencyCycle struct {
+	Projects []*PackageInfo
+}
+
+func NewDependencyCycle(projects []*PackageInfo) *DependencyCycle {
+	return &DependencyCycle{
+		Projects: projects,
+	}
+}
+
+// Checks whether the "from => to" project reference is part of the cycle
+func (cycle *DependencyCycle) ContainsEdge(from *PackageInfo, to *PackageInfo) bool {
+	projects := cycle.Projects
+	for index, project := range projects {
+		nextProject := projects[(index+1)%len(projects)]
+		if project == from && nextProject == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the complete path of the cycle (eg: "A => B => C => A")
+func (cycle *DependencyCycle) FormatPath() string {
+	names := make([]string, len(cycle.Projects)+1)
+	for index, project := range cycle.Projects {
+		names[index] = project.Name
+	}
+	names[len(cycle.Projects)] = cycle.Projects[0].Name
+	return strings.Join(names, " => ")
+}
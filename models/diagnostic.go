@@ -0,0 +1,23 @@
+package models
+
+import "fmt"
+
+// Diagnostic is a non-fatal issue found while loading a project collection
+type Diagnostic struct {
+	PackageInfo *PackageInfo
+	Message     string
+}
+
+func NewDiagnostic(packageInfo *PackageInfo, message string) *Diagnostic {
+	return &Diagnostic{
+		PackageInfo: packageInfo,
+		Message:     message,
+	}
+}
+
+func (diagnostic *Diagnostic) ToString() string {
+	if diagnostic.PackageInfo == nil {
+		return diagnostic.Message
+	}
+	return fmt.Sprintf("%s: %s", diagnostic.PackageInfo.ToString(), diagnostic.Message)
+}
@@ -0,0 +1,10 @@
+package models
+
+type FrameworkCompatibility int
+
+const (
+	FrameworkCompatibility_Unknown      FrameworkCompatibility = 0
+	FrameworkCompatibility_Compatible   FrameworkCompatibility = 1
+	FrameworkCompatibility_FallbackOnly FrameworkCompatibility = 2 //Only usable through "AssetTargetFallback" (NuGet's NU1701/NU1702 warnings)
+	FrameworkCompatibility_Incompatible FrameworkCompatibility = 3
+)
@@ -2,7 +2,9 @@ package models
 
 import (
 	"fmt"
+	"path/filepath"
 	"redun-pendancy/utils"
+	"strings"
 )
 
 type PackageInfo struct {
@@ -11,8 +13,18 @@ type PackageInfo struct {
 	Framework string
 	FilePath  string
 
-	Parents      []*PackageInfo
-	Dependencies []*PackageInfo
+	License    string //SPDX expression (eg: "MIT OR Apache-2.0") or "file:<path>" (as declared in the ".nuspec")
+	LicenseUrl string //Legacy (deprecated) license metadata
+
+	Parents          []*PackageInfo
+	Dependencies     []*PackageInfo
+	DependencyRanges map[string]*VersionRange //DependencyName => VersionRange (as declared in the ".nuspec")
+
+	FrameworkReferences []string //Shared frameworks referenced by a project (eg: "Microsoft.AspNetCore.App")
+
+	PackageId      string //Projects only: "<PackageId>" property (if any)
+	AssemblyName   string //Projects only: "<AssemblyName>" property (if any)
+	ProjectVersion string //Projects only: "<Version>" or "<VersionPrefix>" property (if any)
 
 	PackageType PackageType
 	LoadStatus  LoadStatus
@@ -39,6 +51,22 @@ func (packageInfo *PackageInfo) AddDependency(dependency *PackageInfo) {
 	dependency.Parents = append(dependency.Parents, packageInfo)
 }
 
+func (packageInfo *PackageInfo) SetDependencyRange(packageName string, versionRange *VersionRange) {
+	if packageInfo.DependencyRanges == nil {
+		packageInfo.DependencyRanges = make(map[string]*VersionRange)
+	}
+	packageInfo.DependencyRanges[packageName] = versionRange
+}
+
+// Returns the version range required for the dependency. Dependencies without a declared range require "Version or higher"
+func (packageInfo *PackageInfo) GetDependencyRange(dependency *PackageInfo) *VersionRange {
+	versionRange, exists := packageInfo.DependencyRanges[dependency.Name]
+	if exists {
+		return versionRange
+	}
+	return NewMinimumVersionRange(dependency.Version)
+}
+
 func (packageInfo *PackageInfo) AddDependencySorted(dependency *PackageInfo) {
 	dependency.Parents = append(dependency.Parents, packageInfo)
 	index := utils.IndexOf(packageInfo.Dependencies, 0, func(currDependency *PackageInfo) bool {
@@ -51,6 +79,41 @@ func (packageInfo *PackageInfo) AddDependencySorted(dependency *PackageInfo) {
 	packageInfo.Dependencies = append(packageInfo.Dependencies, dependency)
 }
 
+func (packageInfo *PackageInfo) AddFrameworkReference(frameworkName string) {
+	if !packageInfo.HasFrameworkReference(frameworkName) {
+		packageInfo.FrameworkReferences = append(packageInfo.FrameworkReferences, frameworkName)
+	}
+}
+
+func (packageInfo *PackageInfo) HasFrameworkReference(frameworkName string) bool {
+	for _, frameworkReference := range packageInfo.FrameworkReferences {
+		if strings.EqualFold(frameworkReference, frameworkName) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the package id a project is published with (defaults to its assembly name, then to its file name)
+func (packageInfo *PackageInfo) GetPackageId() string {
+	if packageInfo.PackageId != "" {
+		return packageInfo.PackageId
+	}
+	if packageInfo.AssemblyName != "" {
+		return packageInfo.AssemblyName
+	}
+	return strings.TrimSuffix(packageInfo.Name, filepath.Ext(packageInfo.Name))
+}
+
+// Returns the names a project might also be consumed by as a package (its package id & assembly name)
+func (packageInfo *PackageInfo) GetIdentities() []string {
+	identities := []string{packageInfo.GetPackageId()}
+	if packageInfo.AssemblyName != "" && !strings.EqualFold(packageInfo.AssemblyName, identities[0]) {
+		identities = append(identities, packageInfo.AssemblyName)
+	}
+	return identities
+}
+
 func (packageInfo *PackageInfo) ContainsDependency(packageName string) bool {
 	for _, dependency := range packageInfo.Dependencies {
 		if dependency.Name == packageName {
@@ -101,6 +164,10 @@ func (packageInfo *PackageInfo) IsExeProject() bool {
 	return packageInfo.PackageType == PackageType_ExeProject
 }
 
+func (packageInfo *PackageInfo) IsTestProject() bool {
+	return packageInfo.PackageType == PackageType_TestProject
+}
+
 func (packageInfo *PackageInfo) IsTool() bool {
 	return packageInfo.PackageType == PackageType_Tool
 }
@@ -113,10 +180,22 @@ func (packageInfo *PackageInfo) MarkAsSkipped() {
 	packageInfo.LoadStatus = LoadStatus_Skipped
 }
 
+func (packageInfo *PackageInfo) MarkAsUnresolved() {
+	packageInfo.LoadStatus = LoadStatus_Unresolved
+}
+
+func (packageInfo *PackageInfo) IsUnresolved() bool {
+	return packageInfo.LoadStatus == LoadStatus_Unresolved
+}
+
 func (packageInfo *PackageInfo) MarkAsExeProject() {
 	packageInfo.PackageType = PackageType_ExeProject
 }
 
+func (packageInfo *PackageInfo) MarkAsTestProject() {
+	packageInfo.PackageType = PackageType_TestProject
+}
+
 func (packageInfo *PackageInfo) MarkAsTool() {
 	packageInfo.PackageType = PackageType_Tool
 }
@@ -138,6 +217,49 @@ func (packageInfo *PackageInfo) RemoveDependency(packageName string) bool {
 	return true
 }
 
+// Removes ourselves as the parent of every dependency (eg: the project got removed from the solution), the dependencies are kept as is
+func (packageInfo *PackageInfo) UnlinkDependencies() {
+	for _, dependency := range packageInfo.Dependencies {
+		dependency.Parents = utils.RemoveIf(dependency.Parents, func(parent *PackageInfo) bool {
+			return parent == packageInfo
+		})
+	}
+}
+
+// Adds ourselves back as the parent of every dependency, undoing "UnlinkDependencies()"
+func (packageInfo *PackageInfo) LinkDependencies() {
+	for _, dependency := range packageInfo.Dependencies {
+		dependency.Parents = append(dependency.Parents, packageInfo)
+	}
+}
+
+// Swaps a dependency for another one (eg: a different version), keeping its position
+func (packageInfo *PackageInfo) ReplaceDependency(oldDependency *PackageInfo, newDependency *PackageInfo) bool {
+	if len(packageInfo.Dependencies) == 0 {
+		return false
+	}
+
+	index := utils.IndexOf(packageInfo.Dependencies, 0, func(dependency *PackageInfo) bool {
+		return dependency == oldDependency
+	})
+	if index == -1 {
+		return false
+	}
+
+	oldDependency.Parents = utils.RemoveIf(oldDependency.Parents, func(parent *PackageInfo) bool {
+		//Remove ourselves as the parent
+		return parent == packageInfo
+	})
+	newDependency.Parents = append(newDependency.Parents, packageInfo)
+	packageInfo.Dependencies[index] = newDependency
+	return true
+}
+
+// Returns the license of the package (falling back to its license URL), or "" if unknown
+func (packageInfo *PackageInfo) GetLicense() string {
+	return utils.ValueOrDefault(packageInfo.License, packageInfo.LicenseUrl)
+}
+
 func (packageInfo *PackageInfo) ToString() string {
 	if packageInfo.IsProject() {
 		return fmt.Sprintf("%s [%s]", packageInfo.Name, packageInfo.Framework)
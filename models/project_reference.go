@@ -0,0 +1,15 @@
+package models
+
+// ProjectReference is a project reference as written in the project file, checked against the solution and the file system
+type ProjectReference struct {
+	Project         *PackageInfo //Referenced project
+	IncludePath     string       //As written in the project file (eg: "..\Lib\Lib.csproj")
+	NormalizedPath  string       //Relative path, using the letter case of the file on disk
+	IsAbsolute      bool
+	HasCaseMismatch bool
+	IsInSolution    bool
+}
+
+func (reference *ProjectReference) NeedsNormalization() bool {
+	return reference.IsAbsolute || reference.HasCaseMismatch
+}
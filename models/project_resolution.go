@@ -0,0 +1,92 @@
+package models
+
+import (
+	"strings"
+)
+
+// ResolvedPackage is a package version reached from a project through a specific dependency path
+type ResolvedPackage struct {
+	PackageInfo *PackageInfo
+	Depth       int            //1 => Direct dependency
+	Path        []*PackageInfo //From the project's direct dependency down to (and including) the package
+}
+
+// VersionDowngrade is a package that resolved to a lower version than the one required farther away (NuGet's NU1605)
+type VersionDowngrade struct {
+	Resolved *ResolvedPackage
+	Required *ResolvedPackage
+}
+
+// VersionConflict is a package required with different versions at the same depth ("cousin" dependencies)
+type VersionConflict struct {
+	Chosen      *ResolvedPackage
+	Candidates  []*ResolvedPackage //One per required version
+	Unsatisfied []*ResolvedPackage //Candidates whose version range excludes the chosen version (NuGet's NU1107)
+}
+
+// ProjectResolution holds the effective version of every package in a project's dependency graph
+type ProjectResolution struct {
+	Project    *PackageInfo
+	Resolved   map[string]*ResolvedPackage //PackageName => ResolvedPackage
+	Eliminated []*ResolvedPackage          //Package versions that lost against the effective version
+	Downgrades []*VersionDowngrade
+	Conflicts  []*VersionConflict
+}
+
+func NewResolvedPackage(packageInfo *PackageInfo, parentPath []*PackageInfo) *ResolvedPackage {
+	path := make([]*PackageInfo, len(parentPath)+1)
+	copy(path, parentPath)
+	path[len(parentPath)] = packageInfo
+	return &ResolvedPackage{
+		PackageInfo: packageInfo,
+		Depth:       len(path),
+		Path:        path,
+	}
+}
+
+func (resolvedPackage *ResolvedPackage) GetDirectDependency() *PackageInfo {
+	return resolvedPackage.Path[0]
+}
+
+// Returns whether the package is a direct dependency declared by the project itself (as opposed to an implicit one, eg: a global package reference)
+func (resolvedPackage *ResolvedPackage) IsDeclaredBy(project *PackageInfo) bool {
+	return resolvedPackage.Depth == 1 && project.ContainsDependency(resolvedPackage.PackageInfo.Name)
+}
+
+func (resolvedPackage *ResolvedPackage) FormatPath() string {
+	names := make([]string, len(resolvedPackage.Path))
+	for index, packageInfo := range resolvedPackage.Path {
+		names[index] = packageInfo.Name
+	}
+	return strings.Join(names, "/")
+}
+
+func NewProjectResolution(project *PackageInfo) *ProjectResolution {
+	return &ProjectResolution{
+		Project:  project,
+		Resolved: make(map[string]*ResolvedPackage),
+	}
+}
+
+func (resolution *ProjectResolution) GetEffectivePackage(packageName string) *PackageInfo {
+	resolvedPackage, exists := resolution.Resolved[packageName]
+	if !exists {
+		return nil
+	}
+	return resolvedPackage.PackageInfo
+}
+
+func (resolution *ProjectResolution) IsEffective(packageInfo *PackageInfo) bool {
+	return resolution.GetEffectivePackage(packageInfo.Name) == packageInfo
+}
+
+// Registers a package version that lost against the effective version. Returns false if it was already registered
+func (resolution *ProjectResolution) AddEliminated(candidate *ResolvedPackage) bool {
+	for _, eliminated := range resolution.Eliminated {
+		if eliminated.PackageInfo == candidate.PackageInfo {
+			return false
+		}
+	}
+	resolution.Eliminated = append(resolution.Eliminated, candidate)
+	return true
+}
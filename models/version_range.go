@@ -0,0 +1,103 @@
+package models
+
+import (
+	"fmt"
+	"redun-pendancy/utils"
+	"strings"
+)
+
+// VersionRange is a NuGet version range (eg: "1.0", "[1.0]", "[2.0,3.0)", "(,1.0]").
+//
+// NOTE: A plain version (eg: "1.0") means "1.0 or higher", just like NuGet does.
+type VersionRange struct {
+	MinVersion   string //"" => No lower bound
+	MinInclusive bool
+	MaxVersion   string //"" => No upper bound
+	MaxInclusive bool
+}
+
+func NewMinimumVersionRange(version string) *VersionRange {
+	return &VersionRange{
+		MinVersion:   version,
+		MinInclusive: true,
+	}
+}
+
+func ParseVersionRange(text string) (*VersionRange, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("empty version range")
+	}
+
+	first := text[0]
+	if first != '[' && first != '(' {
+		return NewMinimumVersionRange(text), nil
+	}
+
+	last := text[len(text)-1]
+	if len(text) < 3 || (last != ']' && last != ')') {
+		return nil, fmt.Errorf("invalid version range: %s", text)
+	}
+
+	bounds := strings.Split(text[1:len(text)-1], ",")
+	if len(bounds) == 1 {
+		version := strings.TrimSpace(bounds[0])
+		if first != '[' || last != ']' {
+			return nil, fmt.Errorf("invalid exact version range: %s", text)
+		}
+		return &VersionRange{version, true, version, true}, nil
+	}
+
+	if len(bounds) != 2 {
+		return nil, fmt.Errorf("invalid version range: %s", text)
+	}
+
+	versionRange := &VersionRange{
+		MinVersion:   strings.TrimSpace(bounds[0]),
+		MinInclusive: first == '[',
+		MaxVersion:   strings.TrimSpace(bounds[1]),
+		MaxInclusive: last == ']',
+	}
+	if versionRange.MinVersion == "" && versionRange.MaxVersion == "" {
+		return nil, fmt.Errorf("version range without bounds: %s", text)
+	}
+	return versionRange, nil
+}
+
+// Returns the version the range resolves to when nothing else is required (its lowest version)
+func (versionRange *VersionRange) GetPreferredVersion() string {
+	if versionRange.MinVersion != "" {
+		return versionRange.MinVersion
+	}
+	return versionRange.MaxVersion
+}
+
+func (versionRange *VersionRange) Satisfies(version string) bool {
+	if versionRange.MinVersion != "" {
+		result, err := utils.CompareVersions(version, versionRange.MinVersion)
+		if err != nil || result < 0 || (result == 0 && !versionRange.MinInclusive) {
+			return false
+		}
+	}
+
+	if versionRange.MaxVersion != "" {
+		result, err := utils.CompareVersions(version, versionRange.MaxVersion)
+		if err != nil || result > 0 || (result == 0 && !versionRange.MaxInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+func (versionRange *VersionRange) ToString() string {
+	if versionRange.MaxVersion == "" && versionRange.MinInclusive {
+		return ">= " + versionRange.MinVersion
+	}
+	if versionRange.MinVersion == versionRange.MaxVersion && versionRange.MinInclusive && versionRange.MaxInclusive {
+		return "[" + versionRange.MinVersion + "]"
+	}
+
+	opening := utils.TernarySelect(versionRange.MinInclusive, "[", "(")
+	closing := utils.TernarySelect(versionRange.MaxInclusive, "]", ")")
+	return fmt.Sprintf("%s%s,%s%s", opening, versionRange.MinVersion, versionRange.MaxVersion, closing)
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"redun-pendancy/utils"
+	"strings"
+)
+
+type VersionSpecKind int
+
+const (
+	VersionSpecKind_Pinned    VersionSpecKind = 0 //eg: "1.2.3", "[1.2.3]"
+	VersionSpecKind_Range     VersionSpecKind = 1 //eg: "[1.0,2.0)", "(,2.0]"
+	VersionSpecKind_Unbounded VersionSpecKind = 2 //eg: "[1.0,)", "(1.0,)"
+	VersionSpecKind_Floating  VersionSpecKind = 3 //eg: "*", "1.*", "1.0.0-*"
+	VersionSpecKind_Missing   VersionSpecKind = 4
+	VersionSpecKind_Invalid   VersionSpecKind = 5
+)
+
+// VersionSpec is the version of a package reference as written in a project (or "Directory.Packages.props") file
+type VersionSpec struct {
+	Text         string
+	Kind         VersionSpecKind
+	versionRange *VersionRange //Not set for floating, missing & invalid versions
+}
+
+func ParseVersionSpec(text string) *VersionSpec {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return &VersionSpec{Text: text, Kind: VersionSpecKind_Missing}
+	}
+	if strings.Contains(text, "*") {
+		return &VersionSpec{Text: text, Kind: VersionSpecKind_Floating}
+	}
+
+	versionRange, err := ParseVersionRange(text)
+	if err != nil {
+		return &VersionSpec{Text: text, Kind: VersionSpecKind_Invalid}
+	}
+
+	kind := VersionSpecKind_Pinned
+	isBracketed := text[0] == '[' || text[0] == '('
+	if isBracketed && versionRange.MaxVersion == "" {
+		kind = VersionSpecKind_Unbounded
+	} else if versionRange.MinVersion != versionRange.MaxVersion && isBracketed {
+		kind = VersionSpecKind_Range
+	}
+	return &VersionSpec{Text: text, Kind: kind, versionRange: versionRange}
+}
+
+// Pinned versions & bounded ranges always restore the same version (NuGet picks the lowest version of a range)
+func (versionSpec *VersionSpec) IsReproducible() bool {
+	return versionSpec.Kind == VersionSpecKind_Pinned || versionSpec.Kind == VersionSpecKind_Range
+}
+
+// Returns the lowest version the specification allows (eg: "1.0" for "1.*", "0.0.0" for a missing version)
+func (versionSpec *VersionSpec) GetMinVersion() string {
+	switch versionSpec.Kind {
+	case VersionSpecKind_Floating:
+		return strings.ReplaceAll(versionSpec.Text, "*", "0")
+
+	case VersionSpecKind_Missing:
+		return "0.0.0"
+
+	case VersionSpecKind_Invalid:
+		return versionSpec.Text
+	}
+	return versionSpec.versionRange.GetPreferredVersion()
+}
+
+// Returns the version NuGet would restore out of the available versions (sorted by ascending version), or "" if none matches.
+//
+// NOTE: Floating versions resolve to the highest matching version, anything else to the lowest one.
+func (versionSpec *VersionSpec) Resolve(availableVersions []string) string {
+	switch versionSpec.Kind {
+	case VersionSpecKind_Pinned:
+		return versionSpec.versionRange.GetPreferredVersion()
+
+	case VersionSpecKind_Invalid:
+		return ""
+
+	case VersionSpecKind_Floating:
+		floatPrefix := strings.ToLower(versionSpec.Text[:strings.Index(versionSpec.Text, "*")])
+		allowsPrerelease := strings.Contains(versionSpec.Text, "-")
+		for index := len(availableVersions) - 1; index >= 0; index-- {
+			version := availableVersions[index]
+			if strings.HasPrefix(strings.ToLower(version), floatPrefix) && (allowsPrerelease || !utils.IsPrereleaseVersion(version)) {
+				return version
+			}
+		}
+		return ""
+	}
+
+	minVersion := versionSpec.GetMinVersion()
+	allowsPrerelease := utils.IsPrereleaseVersion(minVersion)
+	for _, version := range availableVersions {
+		if allowsPrerelease || !utils.IsPrereleaseVersion(version) {
+			if versionSpec.versionRange == nil || versionSpec.versionRange.Satisfies(version) {
+				return version
+			}
+		}
+	}
+	return ""
+}
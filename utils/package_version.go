@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PackageVersion is a (SemVer 2.0 like) package version: numeric segments plus an optional prerelease label (eg: "2.0.0-rc.1").
+//
+// NOTE: Build metadata (eg: "+sha.5114f85") is ignored, as NuGet does.
+type PackageVersion struct {
+	Segments   []int
+	Prerelease string //"" => Stable version
+}
+
+func ParsePackageVersion(version string) (*PackageVersion, error) {
+	version, _, _ = strings.Cut(version, "+")
+	numbers, prerelease, _ := strings.Cut(version, "-")
+
+	parts := strings.Split(numbers, ".")
+	segments := make([]int, len(parts))
+	for index, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("%w at segment %d in version '%s'", err, index, version)
+		}
+		segments[index] = number
+	}
+	return &PackageVersion{segments, prerelease}, nil
+}
+
+func (version *PackageVersion) IsPrerelease() bool {
+	return version.Prerelease != ""
+}
+
+// Returns -1, 0 or 1. A prerelease version is lower than the stable version it precedes (eg: "2.0.0-rc" < "2.0.0")
+func (version *PackageVersion) Compare(other *PackageVersion) int {
+	maxCompares := min(len(version.Segments), len(other.Segments))
+	for index := 0; index < maxCompares; index++ {
+		if version.Segments[index] != other.Segments[index] {
+			return TernarySelect(version.Segments[index] > other.Segments[index], 1, -1)
+		}
+	}
+
+	if len(version.Segments) != len(other.Segments) {
+		return TernarySelect(len(version.Segments) > len(other.Segments), 1, -1)
+	}
+
+	if version.IsPrerelease() != other.IsPrerelease() {
+		return TernarySelect(version.IsPrerelease(), -1, 1)
+	}
+	return comparePrereleaseLabels(version.Prerelease, other.Prerelease)
+}
+
+// Compares labels identifier by identifier: numeric ones numerically (and lower than alphanumeric ones), the rest case-insensitively
+func comparePrereleaseLabels(left string, right string) int {
+	leftIdentifiers := strings.Split(strings.ToLower(left), ".")
+	rightIdentifiers := strings.Split(strings.ToLower(right), ".")
+	maxCompares := min(len(leftIdentifiers), len(rightIdentifiers))
+	for index := 0; index < maxCompares; index++ {
+		leftIdentifier, rightIdentifier := leftIdentifiers[index], rightIdentifiers[index]
+		if leftIdentifier == rightIdentifier {
+			continue
+		}
+
+		leftNum, leftErr := strconv.Atoi(leftIdentifier)
+		rightNum, rightErr := strconv.Atoi(rightIdentifier)
+		switch {
+		case leftErr == nil && rightErr == nil:
+			return TernarySelect(leftNum > rightNum, 1, -1)
+		case leftErr == nil:
+			return -1
+		case rightErr == nil:
+			return 1
+		default:
+			return strings.Compare(leftIdentifier, rightIdentifier)
+		}
+	}
+
+	if len(leftIdentifiers) != len(rightIdentifiers) {
+		return TernarySelect(len(leftIdentifiers) > len(rightIdentifiers), 1, -1)
+	}
+	return 0
+}
+
+func IsPrereleaseVersion(version string) bool {
+	packageVersion, err := ParsePackageVersion(version)
+	return err == nil && packageVersion.IsPrerelease()
+}
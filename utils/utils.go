@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path"
 	"regexp"
-	"strconv"
 	"strings"
 	"time"
 )
@@ -19,9 +19,13 @@ func TernarySelect[T any](condition bool, trueValue T, falseValue T) T {
 	return falseValue
 }
 
+// Longest line "OpenAndProcessFileLines()" accepts (generated source files easily exceed bufio's default of 64 KB)
+const maxLineSize = 16 * 1024 * 1024
+
 func OpenAndProcessFileLines(filePath string, handler func(string) error) error {
 	return OpenAndProcessFile(filePath, func(file *os.File) error {
 		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
 		for scanner.Scan() {
 			line := scanner.Text()
 			err := handler(line)
@@ -29,7 +33,7 @@ func OpenAndProcessFileLines(filePath string, handler func(string) error) error
 				return err
 			}
 		}
-		return nil
+		return scanner.Err()
 	})
 }
 
@@ -57,6 +61,17 @@ func TrimPrefixIfMatch(input string, prefix string) (string, bool) {
 	return input, false
 }
 
+// Matches a name against a glob pattern (eg: "*.Domain"), case-insensitively
+func MatchesNamePattern(pattern string, name string) bool {
+	matches, _ := path.Match(strings.ToLower(pattern), strings.ToLower(name))
+	return matches
+}
+
+func IsValidNamePattern(pattern string) bool {
+	_, err := path.Match(pattern, "")
+	return err == nil
+}
+
 // Removes any special characters from a version string
 func NormalizeVersion(version string) string {
 	regex := regexp.MustCompile(`[\[\]]`)
@@ -77,35 +92,16 @@ func CompareVersions(left string, right string) (int, error) {
 		return 0, nil
 	}
 
-	leftParts := strings.Split(left, ".")
-	rightParts := strings.Split(right, ".")
-	maxCompares := min(len(leftParts), len(rightParts))
-
-	for index := 0; index < maxCompares; index++ {
-		leftNum, err := strconv.Atoi(leftParts[index])
-		if err != nil {
-			return 0xDEADC0DE, fmt.Errorf("%w at segment %d in left version '%s'", err, index, left)
-		}
-
-		rightNum, err := strconv.Atoi(rightParts[index])
-		if err != nil {
-			return 0xDEADC0DE, fmt.Errorf("%w at segment %d in right version '%s'", err, index, right)
-		}
-
-		if leftNum > rightNum {
-			return 1, nil
-		} else if leftNum < rightNum {
-			return -1, nil
-		}
+	leftVersion, err := ParsePackageVersion(left)
+	if err != nil {
+		return 0xDEADC0DE, fmt.Errorf("left %w", err)
 	}
 
-	if len(leftParts) > len(rightParts) {
-		return 1, nil
-	} else if len(leftParts) < len(rightParts) {
-		return -1, nil
+	rightVersion, err := ParsePackageVersion(right)
+	if err != nil {
+		return 0xDEADC0DE, fmt.Errorf("right %w", err)
 	}
-
-	return 0, nil
+	return leftVersion.Compare(rightVersion), nil
 }
 
 func BuildPackageKey(name string, version string, framework string) string {